@@ -276,6 +276,8 @@ func IsUpperCase(c byte) bool {
 // • the appropriate truncation indicator may be locale-dependent
 //
 // • it is safe to use non-ASCII characters in the truncation indicator
+//
+// For arbitrary Unicode text, use strs.Truncate, which never splits grapheme clusters.
 func Truncate(str string, maxLen int, truncInd string) (string, error) {
 	// Length to truncate the string to, not including the truncation indicator.
 	nTruncInd := utf8.RuneCountInString(truncInd)
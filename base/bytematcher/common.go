@@ -0,0 +1,188 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bytematcher provides matchers that operate on byte slices rather than on strings.
+//
+// A Matcher matches single bytes, e.g., ASCII character classes, whereas a RuneMatcher applies a runematcher.Matcher
+// to the UTF-8 encoded runes of a byte slice. Both offer basic text processing methods, which never convert the input
+// to a string, and both can be used for filtering streams by means of the transformers Remove, Collapse and Trim.
+package bytematcher
+
+// SliceMatcher is implemented by Matcher and RuneMatcher, which match single bytes and UTF-8 encoded runes,
+// respectively. Throughout the documentation, a "unit" is either a single byte or the UTF-8 encoding of a rune.
+//
+// All indices are byte offsets.
+type SliceMatcher interface {
+	// MatchesAnyOf returns true if a byte slice contains at least one matching unit.
+	MatchesAnyOf(b []byte) bool
+
+	// MatchesAllOf returns true if a byte slice contains only matching units.
+	MatchesAllOf(b []byte) bool
+
+	// MatchesNoneOf returns true if a byte slice contains no matching units.
+	MatchesNoneOf(b []byte) bool
+
+	// IndexIn returns the byte offset of the first matching unit in a byte slice, starting from a given offset, or
+	// -1 if no unit matches after that offset.
+	IndexIn(b []byte, start int) int
+
+	// LastIndexIn returns the byte offset of the last matching unit in a byte slice, or -1 if no matching unit is
+	// present.
+	LastIndexIn(b []byte) int
+
+	// CountIn returns the number of matching units found in a byte slice.
+	CountIn(b []byte) int
+
+	// RemoveFrom returns a new byte slice containing all non-matching units of a byte slice, in order.
+	RemoveFrom(b []byte) []byte
+
+	// RetainFrom returns a new byte slice containing all matching units of a byte slice, in order.
+	RetainFrom(b []byte) []byte
+
+	// ReplaceFrom returns a copy of the input byte slice, with each matching unit replaced by a given replacement.
+	ReplaceFrom(b []byte, replacement []byte) []byte
+
+	// TrimFrom returns a subslice of the input byte slice that omits all matching units from the beginning and from
+	// the end of the slice.
+	TrimFrom(b []byte) []byte
+
+	// TrimLeadingFrom returns a subslice of the input byte slice that omits all matching units from the beginning of
+	// the slice.
+	TrimLeadingFrom(b []byte) []byte
+
+	// TrimTrailingFrom returns a subslice of the input byte slice that omits all matching units from the end of the
+	// slice.
+	TrimTrailingFrom(b []byte) []byte
+
+	// matchPrefix reports whether the first unit of the non-empty slice b matches and returns its size.
+	// If b does not contain a full unit and more input may follow (i.e., atEOF is false), the size is 0.
+	matchPrefix(b []byte, atEOF bool) (matches bool, size int)
+
+	// matchSuffix reports whether the last unit of the non-empty slice b matches and returns its size.
+	matchSuffix(b []byte) (matches bool, size int)
+}
+
+func matchesAllOf(m SliceMatcher, b []byte) bool {
+	for i := 0; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if !matches {
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+func indexIn(m SliceMatcher, b []byte, start int) int {
+	if start < 0 || start >= len(b) {
+		return -1
+	}
+
+	for i := start; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if matches {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
+
+func lastIndexIn(m SliceMatcher, b []byte) int {
+	for i := len(b); i > 0; {
+		matches, size := m.matchSuffix(b[:i])
+		i -= size
+		if matches {
+			return i
+		}
+	}
+	return -1
+}
+
+func countIn(m SliceMatcher, b []byte) int {
+	count := 0
+	for i := 0; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if matches {
+			count++
+		}
+		i += size
+	}
+	return count
+}
+
+// filter returns a new byte slice containing all units of b, which either match or do not match.
+func filter(m SliceMatcher, b []byte, keep bool) []byte {
+	res := make([]byte, 0, len(b))
+	for i := 0; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if matches == keep {
+			res = append(res, b[i:i+size]...)
+		}
+		i += size
+	}
+	return res
+}
+
+func replaceFrom(m SliceMatcher, b []byte, replacement []byte) []byte {
+	res := make([]byte, 0, len(b))
+	for i := 0; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if matches {
+			res = append(res, replacement...)
+		} else {
+			res = append(res, b[i:i+size]...)
+		}
+		i += size
+	}
+	return res
+}
+
+func trimLeadingFrom(m SliceMatcher, b []byte) []byte {
+	for len(b) > 0 {
+		matches, size := m.matchPrefix(b, true)
+		if !matches {
+			break
+		}
+		b = b[size:]
+	}
+	return b
+}
+
+func trimTrailingFrom(m SliceMatcher, b []byte) []byte {
+	for len(b) > 0 {
+		matches, size := m.matchSuffix(b)
+		if !matches {
+			break
+		}
+		b = b[:len(b)-size]
+	}
+	return b
+}
+
+func collapseFrom(m SliceMatcher, b []byte, replacement []byte) []byte {
+	res := make([]byte, 0, len(b))
+	inMatchingGroup := false
+	for i := 0; i < len(b); {
+		matches, size := m.matchPrefix(b[i:], true)
+		if !matches {
+			res = append(res, b[i:i+size]...)
+		} else if !inMatchingGroup {
+			res = append(res, replacement...)
+		}
+		inMatchingGroup = matches
+		i += size
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/abc-inc/goava/base/bytematcher"
+	"github.com/abc-inc/goava/base/runematcher"
+	"golang.org/x/text/transform"
+)
+
+func Example() {
+	m := bytematcher.Whitespace()
+	line := []byte("  GET   /index.html  200 ")
+	fmt.Printf("%s\n", m.TrimAndCollapseFrom(line, ' '))
+	fmt.Println(bytematcher.Digit().CountIn(line))
+	// Output:
+	// GET /index.html 200
+	// 3
+}
+
+func ExampleCollapse() {
+	r := strings.NewReader("a  b \t c\n")
+	m := bytematcher.UTF8(runematcher.Whitespace())
+	_, _ = io.Copy(os.Stdout, transform.NewReader(r, transform.Chain(bytematcher.Trim(m), bytematcher.Collapse(m, "_"))))
+	// Output:
+	// a_b_c
+}
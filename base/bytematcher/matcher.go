@@ -0,0 +1,261 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Matcher determines a true or false value for any byte, e.g., whether it belongs to an ASCII character class.
+// Also offers basic text processing methods based on this function.
+//
+// The zero value matches no byte.
+type Matcher struct {
+	set  [4]uint64
+	desc string
+}
+
+// Matches determines a true or false value for the given byte.
+func (m Matcher) Matches(b byte) bool {
+	return m.set[b>>6]&(1<<(b&63)) != 0
+}
+
+// Negate returns a matcher that matches any byte not matched by this matcher.
+func (m Matcher) Negate() Matcher {
+	return Matcher{[4]uint64{^m.set[0], ^m.set[1], ^m.set[2], ^m.set[3]}, m.String() + ".negate()"}
+}
+
+// And returns a matcher that matches any byte matched by both this matcher and other.
+func (m Matcher) And(other Matcher) Matcher {
+	set := [4]uint64{}
+	for i := range set {
+		set[i] = m.set[i] & other.set[i]
+	}
+	return Matcher{set, "ByteMatcher.and(" + m.String() + ", " + other.String() + ")"}
+}
+
+// Or returns a matcher that matches any byte matched by either this matcher or other.
+func (m Matcher) Or(other Matcher) Matcher {
+	set := [4]uint64{}
+	for i := range set {
+		set[i] = m.set[i] | other.set[i]
+	}
+	return Matcher{set, "ByteMatcher.or(" + m.String() + ", " + other.String() + ")"}
+}
+
+// MatchesAnyOf returns true if a byte slice contains at least one matching byte.
+func (m Matcher) MatchesAnyOf(b []byte) bool {
+	return m.IndexIn(b, 0) != -1
+}
+
+// MatchesAllOf returns true if a byte slice contains only matching bytes.
+func (m Matcher) MatchesAllOf(b []byte) bool {
+	return matchesAllOf(m, b)
+}
+
+// MatchesNoneOf returns true if a byte slice contains no matching bytes.
+func (m Matcher) MatchesNoneOf(b []byte) bool {
+	return m.IndexIn(b, 0) == -1
+}
+
+// IndexIn returns the index of the first matching byte in a byte slice, starting from a given index, or -1 if no
+// byte matches after that index.
+func (m Matcher) IndexIn(b []byte, start int) int {
+	return indexIn(m, b, start)
+}
+
+// LastIndexIn returns the index of the last matching byte in a byte slice, or -1 if no matching byte is present.
+func (m Matcher) LastIndexIn(b []byte) int {
+	return lastIndexIn(m, b)
+}
+
+// CountIn returns the number of matching bytes found in a byte slice.
+func (m Matcher) CountIn(b []byte) int {
+	return countIn(m, b)
+}
+
+// RemoveFrom returns a new byte slice containing all non-matching bytes of a byte slice, in order.
+func (m Matcher) RemoveFrom(b []byte) []byte {
+	return filter(m, b, false)
+}
+
+// RetainFrom returns a new byte slice containing all matching bytes of a byte slice, in order.
+func (m Matcher) RetainFrom(b []byte) []byte {
+	return filter(m, b, true)
+}
+
+// ReplaceFromByte returns a copy of the input byte slice, with each matching byte replaced by a given replacement
+// byte.
+func (m Matcher) ReplaceFromByte(b []byte, replacement byte) []byte {
+	res := make([]byte, len(b))
+	for i, c := range b {
+		if m.Matches(c) {
+			c = replacement
+		}
+		res[i] = c
+	}
+	return res
+}
+
+// ReplaceFrom returns a copy of the input byte slice, with each matching byte replaced by a given replacement
+// sequence.
+func (m Matcher) ReplaceFrom(b []byte, replacement []byte) []byte {
+	return replaceFrom(m, b, replacement)
+}
+
+// TrimFrom returns a subslice of the input byte slice that omits all matching bytes from the beginning and from the
+// end of the slice.
+func (m Matcher) TrimFrom(b []byte) []byte {
+	return trimTrailingFrom(m, trimLeadingFrom(m, b))
+}
+
+// TrimLeadingFrom returns a subslice of the input byte slice that omits all matching bytes from the beginning of the
+// slice.
+func (m Matcher) TrimLeadingFrom(b []byte) []byte {
+	return trimLeadingFrom(m, b)
+}
+
+// TrimTrailingFrom returns a subslice of the input byte slice that omits all matching bytes from the end of the
+// slice.
+func (m Matcher) TrimTrailingFrom(b []byte) []byte {
+	return trimTrailingFrom(m, b)
+}
+
+// CollapseFrom returns a copy of the input byte slice, with each group of consecutive matching bytes replaced by a
+// single replacement byte.
+func (m Matcher) CollapseFrom(b []byte, replacement byte) []byte {
+	return collapseFrom(m, b, []byte{replacement})
+}
+
+// TrimAndCollapseFrom collapses groups of matching bytes exactly as CollapseFrom(b, replacement) does, except that
+// groups of matching bytes at the start or end of the slice are removed without replacement.
+func (m Matcher) TrimAndCollapseFrom(b []byte, replacement byte) []byte {
+	return m.CollapseFrom(m.TrimFrom(b), replacement)
+}
+
+// String returns a string representation of this Matcher.
+func (m Matcher) String() string {
+	if m.desc == "" {
+		return "ByteMatcher.none()"
+	}
+	return m.desc
+}
+
+func (m Matcher) matchPrefix(b []byte, _ bool) (matches bool, size int) {
+	return m.Matches(b[0]), 1
+}
+
+func (m Matcher) matchSuffix(b []byte) (matches bool, size int) {
+	return m.Matches(b[len(b)-1]), 1
+}
+
+// Any matches any byte.
+func Any() Matcher {
+	return Matcher{[4]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}, "ByteMatcher.any()"}
+}
+
+// None matches no bytes.
+func None() Matcher {
+	return Matcher{desc: "ByteMatcher.none()"}
+}
+
+// ASCII determines whether a byte is ASCII, meaning that its value is less than 128.
+func ASCII() Matcher {
+	return InRange(0x00, 0x7F).named("ByteMatcher.ascii()")
+}
+
+// Whitespace determines whether a byte is ASCII whitespace, i.e., '\t', '\n', '\v', '\f', '\r' or ' '.
+func Whitespace() Matcher {
+	return AnyOf("\t\n\v\f\r ").named("ByteMatcher.whitespace()")
+}
+
+// Digit determines whether a byte is an ASCII digit, i.e., '0' through '9'.
+func Digit() Matcher {
+	return InRange('0', '9').named("ByteMatcher.digit()")
+}
+
+// UpperCase determines whether a byte is an uppercase ASCII letter, i.e., 'A' through 'Z'.
+func UpperCase() Matcher {
+	return InRange('A', 'Z').named("ByteMatcher.upperCase()")
+}
+
+// LowerCase determines whether a byte is a lowercase ASCII letter, i.e., 'a' through 'z'.
+func LowerCase() Matcher {
+	return InRange('a', 'z').named("ByteMatcher.lowerCase()")
+}
+
+// Letter determines whether a byte is an ASCII letter, i.e., 'A' through 'Z' or 'a' through 'z'.
+func Letter() Matcher {
+	return UpperCase().Or(LowerCase()).named("ByteMatcher.letter()")
+}
+
+// LetterOrDigit determines whether a byte is an ASCII letter or digit.
+func LetterOrDigit() Matcher {
+	return Letter().Or(Digit()).named("ByteMatcher.letterOrDigit()")
+}
+
+// Control determines whether a byte is an ASCII control character, i.e., 0x00 through 0x1F or 0x7F.
+func Control() Matcher {
+	return InRange(0x00, 0x1F).Or(Is(0x7F)).named("ByteMatcher.control()")
+}
+
+// Is returns a byte matcher that matches only one specified byte.
+func Is(b byte) Matcher {
+	m := Matcher{desc: fmt.Sprintf("ByteMatcher.is(0x%02X)", b)}
+	m.set[b>>6] |= 1 << (b & 63)
+	return m
+}
+
+// AnyOf returns a byte matcher that matches any byte present in the given sequence.
+func AnyOf(chars string) Matcher {
+	m := Matcher{desc: "ByteMatcher.anyOf(" + strconv.Quote(chars) + ")"}
+	for i := 0; i < len(chars); i++ {
+		m.set[chars[i]>>6] |= 1 << (chars[i] & 63)
+	}
+	return m
+}
+
+// NoneOf returns a byte matcher that matches any byte not present in the given sequence.
+func NoneOf(chars string) Matcher {
+	return AnyOf(chars).Negate()
+}
+
+// InRange returns a byte matcher that matches any byte in a given range (both endpoints are inclusive).
+func InRange(startIncl, endIncl byte) Matcher {
+	m := Matcher{desc: fmt.Sprintf("ByteMatcher.inRange(0x%02X, 0x%02X)", startIncl, endIncl)}
+	for b := int(startIncl); b <= int(endIncl); b++ {
+		m.set[b>>6] |= 1 << (b & 63)
+	}
+	return m
+}
+
+// ForPredicate returns a byte matcher with identical behavior to the given byte-based predicate.
+// The predicate is evaluated once for every possible byte value.
+func ForPredicate(p func(byte) bool) Matcher {
+	m := Matcher{desc: "ByteMatcher.forPredicate()"}
+	for b := 0; b <= 0xFF; b++ {
+		if p(byte(b)) {
+			m.set[b>>6] |= 1 << (b & 63)
+		}
+	}
+	return m
+}
+
+// named returns a copy of m with the given description.
+func (m Matcher) named(desc string) Matcher {
+	m.desc = desc
+	return m
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher_test
+
+import (
+	"testing"
+	"unicode"
+
+	. "github.com/abc-inc/goava/base/bytematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestMatcher_Classes(t *testing.T) {
+	tests := []struct {
+		m    Matcher
+		exp  func(b byte) bool
+		desc string
+	}{
+		{Any(), func(b byte) bool { return true }, "ByteMatcher.any()"},
+		{None(), func(b byte) bool { return false }, "ByteMatcher.none()"},
+		{Matcher{}, func(b byte) bool { return false }, "ByteMatcher.none()"},
+		{ASCII(), func(b byte) bool { return b < 0x80 }, "ByteMatcher.ascii()"},
+		{Whitespace(), func(b byte) bool { return unicode.IsSpace(rune(b)) && b < 0x80 }, "ByteMatcher.whitespace()"},
+		{Digit(), func(b byte) bool { return b >= '0' && b <= '9' }, "ByteMatcher.digit()"},
+		{UpperCase(), func(b byte) bool { return b >= 'A' && b <= 'Z' }, "ByteMatcher.upperCase()"},
+		{LowerCase(), func(b byte) bool { return b >= 'a' && b <= 'z' }, "ByteMatcher.lowerCase()"},
+		{Letter(), func(b byte) bool { return unicode.IsLetter(rune(b)) && b < 0x80 }, "ByteMatcher.letter()"},
+		{LetterOrDigit(), func(b byte) bool {
+			return (unicode.IsLetter(rune(b)) || unicode.IsDigit(rune(b))) && b < 0x80
+		}, "ByteMatcher.letterOrDigit()"},
+		{Control(), func(b byte) bool { return unicode.IsControl(rune(b)) && b < 0x80 }, "ByteMatcher.control()"},
+		{Is('x'), func(b byte) bool { return b == 'x' }, "ByteMatcher.is(0x78)"},
+		{AnyOf("a-z"), func(b byte) bool { return b == 'a' || b == '-' || b == 'z' }, `ByteMatcher.anyOf("a-z")`},
+		{InRange(0xF0, 0xFF), func(b byte) bool { return b >= 0xF0 }, "ByteMatcher.inRange(0xF0, 0xFF)"},
+		{ForPredicate(func(b byte) bool { return b%64 == 0 }), func(b byte) bool { return b%64 == 0 },
+			"ByteMatcher.forPredicate()"},
+	}
+
+	for _, tc := range tests {
+		Equal(t, tc.desc, tc.m.String())
+		for b := 0; b <= 0xFF; b++ {
+			Equal(t, tc.exp(byte(b)), tc.m.Matches(byte(b)), "%s: 0x%02X", tc.desc, b)
+			Equal(t, !tc.exp(byte(b)), tc.m.Negate().Matches(byte(b)), "%s: 0x%02X", tc.desc, b)
+		}
+	}
+}
+
+func TestMatcher_LogicalOps(t *testing.T) {
+	m := Letter().And(AnyOf("a1B"))
+	True(t, m.MatchesAllOf([]byte("aB")))
+	False(t, m.Matches('1'))
+	Equal(t, `ByteMatcher.and(ByteMatcher.letter(), ByteMatcher.anyOf("a1B"))`, m.String())
+
+	m = Digit().Or(Is('.')).Negate()
+	False(t, m.MatchesAnyOf([]byte("3.14")))
+	Equal(t, "ByteMatcher.or(ByteMatcher.digit(), ByteMatcher.is(0x2E)).negate()", m.String())
+	Equal(t, NoneOf("x").String(), `ByteMatcher.anyOf("x").negate()`)
+}
+
+func TestMatcher_Methods(t *testing.T) {
+	m := Whitespace()
+	b := []byte("  a\tb  c ")
+	True(t, m.MatchesAnyOf(b))
+	False(t, m.MatchesAllOf(b))
+	False(t, m.MatchesNoneOf(b))
+	True(t, m.MatchesAllOf(nil))
+	Equal(t, 0, m.IndexIn(b, 0))
+	Equal(t, 3, m.IndexIn(b, 2))
+	Equal(t, -1, m.IndexIn(b, -1))
+	Equal(t, -1, m.IndexIn(b, len(b)))
+	Equal(t, 8, m.LastIndexIn(b))
+	Equal(t, -1, m.LastIndexIn([]byte("abc")))
+	Equal(t, 6, m.CountIn(b))
+	Equal(t, "abc", string(m.RemoveFrom(b)))
+	Equal(t, "  \t   ", string(m.RetainFrom(b)))
+	Equal(t, "__a_b__c_", string(m.ReplaceFromByte(b, '_')))
+	Equal(t, "<><>a<>b<><>c<>", string(m.ReplaceFrom(b, []byte("<>"))))
+	Equal(t, "a\tb  c", string(m.TrimFrom(b)))
+	Equal(t, "a\tb  c ", string(m.TrimLeadingFrom(b)))
+	Equal(t, "  a\tb  c", string(m.TrimTrailingFrom(b)))
+	Equal(t, "_a_b_c_", string(m.CollapseFrom(b, '_')))
+	Equal(t, "a_b_c", string(m.TrimAndCollapseFrom(b, '_')))
+	Equal(t, "", string(m.TrimFrom([]byte("   "))))
+	Equal(t, "  a\tb  c ", string(b))
+}
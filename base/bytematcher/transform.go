@@ -0,0 +1,148 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import "golang.org/x/text/transform"
+
+// Remove returns a transformer, which removes all units matched by m, e.g., for use with transform.NewReader.
+// It is the streaming equivalent of RemoveFrom.
+func Remove(m SliceMatcher) transform.Transformer {
+	return remover{m: m}
+}
+
+// Collapse returns a transformer, which replaces each group of consecutive units matched by m with a single
+// replacement. It is the streaming equivalent of CollapseFrom.
+func Collapse(m SliceMatcher, replacement string) transform.Transformer {
+	return &collapser{m: m, repl: replacement}
+}
+
+// Trim returns a transformer, which omits all units matched by m from the beginning and from the end of the input.
+// It is the streaming equivalent of TrimFrom.
+//
+// Groups of matching units are buffered until a non-matching unit or the end of the input is encountered.
+func Trim(m SliceMatcher) transform.Transformer {
+	return &trimmer{m: m}
+}
+
+// remover removes all matching units.
+type remover struct {
+	transform.NopResetter
+	m SliceMatcher
+}
+
+// Transform writes to dst the transformed bytes read from src, and returns the number of dst bytes written and src
+// bytes read.
+func (t remover) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		matches, size := t.m.matchPrefix(src[nSrc:], atEOF)
+		if size == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if !matches {
+			if len(dst)-nDst < size {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		}
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// collapser replaces each group of consecutive matching units with a single replacement.
+type collapser struct {
+	m               SliceMatcher
+	repl            string
+	inMatchingGroup bool
+}
+
+// Reset resets the state and allows a Transformer to be reused.
+func (t *collapser) Reset() {
+	t.inMatchingGroup = false
+}
+
+// Transform writes to dst the transformed bytes read from src, and returns the number of dst bytes written and src
+// bytes read.
+func (t *collapser) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		matches, size := t.m.matchPrefix(src[nSrc:], atEOF)
+		if size == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if !matches {
+			if len(dst)-nDst < size {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		} else if !t.inMatchingGroup {
+			if len(dst)-nDst < len(t.repl) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], t.repl)
+		}
+		t.inMatchingGroup = matches
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// trimmer omits all matching units from the beginning and from the end of the input.
+type trimmer struct {
+	m       SliceMatcher
+	started bool   // whether a non-matching unit has been written
+	pending []byte // matching units, which must be written if a non-matching unit follows
+}
+
+// Reset resets the state and allows a Transformer to be reused.
+func (t *trimmer) Reset() {
+	t.started = false
+	t.pending = t.pending[:0]
+}
+
+// Transform writes to dst the transformed bytes read from src, and returns the number of dst bytes written and src
+// bytes read.
+func (t *trimmer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		matches, size := t.m.matchPrefix(src[nSrc:], atEOF)
+		if size == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if matches {
+			if t.started {
+				t.pending = append(t.pending, src[nSrc:nSrc+size]...)
+			}
+			nSrc += size
+			continue
+		}
+
+		// a non-matching unit follows, hence the pending units are not trailing
+		n := copy(dst[nDst:], t.pending)
+		nDst += n
+		t.pending = t.pending[:copy(t.pending, t.pending[n:])]
+		if len(t.pending) > 0 || len(dst)-nDst < size {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		t.started = true
+		nSrc += size
+	}
+
+	if atEOF {
+		t.pending = t.pending[:0]
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/abc-inc/goava/base/bytematcher"
+	"github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+	"golang.org/x/text/transform"
+)
+
+// transformAll transforms s by reading it byte by byte, which forces the transformer to handle incomplete input.
+func transformAll(t *testing.T, tr transform.Transformer, s string) string {
+	b, err := io.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(s)), tr))
+	NoError(t, err)
+	return string(b)
+}
+
+func TestRemove(t *testing.T) {
+	Equal(t, "abc", transformAll(t, Remove(Whitespace()), " a\tb\n c "))
+	Equal(t, "ä東\U0001F600", transformAll(t, Remove(UTF8(runematcher.Whitespace())),
+		"　ä 東 \U0001F600 "))
+	Equal(t, "a\xE6\x9D", transformAll(t, Remove(UTF8(runematcher.Whitespace())), "a \xE6\x9D"))
+
+	s, _, err := transform.String(Remove(Digit()), "a1b22c333")
+	NoError(t, err)
+	Equal(t, "abc", s)
+}
+
+func TestCollapse(t *testing.T) {
+	tr := Collapse(Whitespace(), "_")
+	Equal(t, "_a_b_c_", transformAll(t, tr, "  a\tb \n c "))
+	Equal(t, "a", transformAll(t, tr, "a"))
+	Equal(t, "_", transformAll(t, tr, "   "))
+	Equal(t, "–ä–東–", transformAll(t, Collapse(UTF8(runematcher.Whitespace()), "–"),
+		"　ä  東 "))
+}
+
+func TestTrim(t *testing.T) {
+	tr := Trim(Whitespace())
+	Equal(t, "a\tb \n c", transformAll(t, tr, "  a\tb \n c "))
+	Equal(t, "", transformAll(t, tr, "   "))
+	Equal(t, "", transformAll(t, tr, ""))
+	Equal(t, "ä  東", transformAll(t, Trim(UTF8(runematcher.Whitespace())),
+		"　ä  東 "))
+}
+
+func TestTrim_LongGroup(t *testing.T) {
+	// the group of inner whitespace is larger than the buffers of transform.Reader
+	s := " a" + strings.Repeat(" ", 10_000) + "b "
+	b, err := io.ReadAll(transform.NewReader(strings.NewReader(s), Trim(Whitespace())))
+	NoError(t, err)
+	Equal(t, strings.TrimSpace(s), string(b))
+}
+
+func TestTransformer_Reset(t *testing.T) {
+	for _, tr := range []transform.Transformer{Remove(Digit()), Collapse(Digit(), "#"), Trim(Digit())} {
+		first, _, err := transform.String(tr, "1a1")
+		NoError(t, err)
+		second, _, err := transform.String(tr, "1a1")
+		NoError(t, err)
+		Equal(t, first, second)
+	}
+}
+
+func TestTransformer_ShortDst(t *testing.T) {
+	dst := make([]byte, 3)
+	nDst, nSrc, err := Collapse(Whitespace(), "<->").Transform(dst, []byte("a  b"), true)
+	ErrorIs(t, err, transform.ErrShortDst)
+	Equal(t, 1, nDst)
+	Equal(t, 1, nSrc)
+
+	var buf bytes.Buffer
+	w := transform.NewWriter(&buf, Collapse(Whitespace(), "<->"))
+	_, err = w.Write([]byte("a  b "))
+	NoError(t, err)
+	NoError(t, w.Close())
+	Equal(t, "a<->b<->", buf.String())
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher
+
+import (
+	"unicode/utf8"
+
+	"github.com/abc-inc/goava/base/runematcher"
+)
+
+// RuneMatcher applies a runematcher.Matcher to the UTF-8 encoded runes of byte slices.
+// Also offers basic text processing methods based on this function.
+//
+// Invalid UTF-8 sequences are processed byte by byte and matched as utf8.RuneError (U+FFFD).
+// All indices are byte offsets, which are expected to be at rune boundaries.
+type RuneMatcher struct {
+	m runematcher.Matcher
+}
+
+// UTF8 returns a matcher, which applies m to the UTF-8 encoded runes of byte slices.
+func UTF8(m runematcher.Matcher) RuneMatcher {
+	return RuneMatcher{m}
+}
+
+// Matcher returns the underlying runematcher.Matcher.
+func (m RuneMatcher) Matcher() runematcher.Matcher {
+	return m.m
+}
+
+// MatchesAnyOf returns true if a byte slice contains at least one matching rune.
+func (m RuneMatcher) MatchesAnyOf(b []byte) bool {
+	return m.IndexIn(b, 0) != -1
+}
+
+// MatchesAllOf returns true if a byte slice contains only matching runes.
+func (m RuneMatcher) MatchesAllOf(b []byte) bool {
+	return matchesAllOf(m, b)
+}
+
+// MatchesNoneOf returns true if a byte slice contains no matching runes.
+func (m RuneMatcher) MatchesNoneOf(b []byte) bool {
+	return m.IndexIn(b, 0) == -1
+}
+
+// IndexIn returns the byte offset of the first matching rune in a byte slice, starting from a given offset, or -1 if
+// no rune matches after that offset.
+func (m RuneMatcher) IndexIn(b []byte, start int) int {
+	return indexIn(m, b, start)
+}
+
+// LastIndexIn returns the byte offset of the last matching rune in a byte slice, or -1 if no matching rune is
+// present.
+func (m RuneMatcher) LastIndexIn(b []byte) int {
+	return lastIndexIn(m, b)
+}
+
+// CountIn returns the number of matching runes found in a byte slice.
+func (m RuneMatcher) CountIn(b []byte) int {
+	return countIn(m, b)
+}
+
+// RemoveFrom returns a new byte slice containing all non-matching runes of a byte slice, in order.
+func (m RuneMatcher) RemoveFrom(b []byte) []byte {
+	return filter(m, b, false)
+}
+
+// RetainFrom returns a new byte slice containing all matching runes of a byte slice, in order.
+func (m RuneMatcher) RetainFrom(b []byte) []byte {
+	return filter(m, b, true)
+}
+
+// ReplaceFromRune returns a copy of the input byte slice, with each matching rune replaced by a given replacement
+// rune.
+func (m RuneMatcher) ReplaceFromRune(b []byte, replacement rune) []byte {
+	return replaceFrom(m, b, utf8.AppendRune(nil, replacement))
+}
+
+// ReplaceFrom returns a copy of the input byte slice, with each matching rune replaced by a given replacement
+// sequence.
+func (m RuneMatcher) ReplaceFrom(b []byte, replacement []byte) []byte {
+	return replaceFrom(m, b, replacement)
+}
+
+// TrimFrom returns a subslice of the input byte slice that omits all matching runes from the beginning and from the
+// end of the slice.
+func (m RuneMatcher) TrimFrom(b []byte) []byte {
+	return trimTrailingFrom(m, trimLeadingFrom(m, b))
+}
+
+// TrimLeadingFrom returns a subslice of the input byte slice that omits all matching runes from the beginning of the
+// slice.
+func (m RuneMatcher) TrimLeadingFrom(b []byte) []byte {
+	return trimLeadingFrom(m, b)
+}
+
+// TrimTrailingFrom returns a subslice of the input byte slice that omits all matching runes from the end of the
+// slice.
+func (m RuneMatcher) TrimTrailingFrom(b []byte) []byte {
+	return trimTrailingFrom(m, b)
+}
+
+// CollapseFrom returns a copy of the input byte slice, with each group of consecutive matching runes replaced by a
+// single replacement rune.
+func (m RuneMatcher) CollapseFrom(b []byte, replacement rune) []byte {
+	return collapseFrom(m, b, utf8.AppendRune(nil, replacement))
+}
+
+// TrimAndCollapseFrom collapses groups of matching runes exactly as CollapseFrom(b, replacement) does, except that
+// groups of matching runes at the start or end of the slice are removed without replacement.
+func (m RuneMatcher) TrimAndCollapseFrom(b []byte, replacement rune) []byte {
+	return m.CollapseFrom(m.TrimFrom(b), replacement)
+}
+
+// String returns a string representation of this RuneMatcher.
+func (m RuneMatcher) String() string {
+	return "ByteMatcher.utf8(" + m.m.String() + ")"
+}
+
+func (m RuneMatcher) matchPrefix(b []byte, atEOF bool) (matches bool, size int) {
+	if !atEOF && !utf8.FullRune(b) {
+		return false, 0
+	}
+	r, size := utf8.DecodeRune(b)
+	return m.m.Matches(r), size
+}
+
+func (m RuneMatcher) matchSuffix(b []byte) (matches bool, size int) {
+	r, size := utf8.DecodeLastRune(b)
+	return m.m.Matches(r), size
+}
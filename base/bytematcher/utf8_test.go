@@ -0,0 +1,57 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytematcher_test
+
+import (
+	"testing"
+
+	. "github.com/abc-inc/goava/base/bytematcher"
+	"github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestRuneMatcher(t *testing.T) {
+	m := UTF8(runematcher.Whitespace())
+	b := []byte("\u3000\u00E4 \u6771\u00A0\U0001F600 ")
+	Equal(t, "ByteMatcher.utf8(Matcher.whitespace())", m.String())
+	Equal(t, runematcher.Whitespace(), m.Matcher())
+	True(t, m.MatchesAnyOf(b))
+	False(t, m.MatchesAllOf(b))
+	False(t, m.MatchesNoneOf(b))
+	Equal(t, 0, m.IndexIn(b, 0))
+	Equal(t, 5, m.IndexIn(b, 3))
+	Equal(t, 9, m.IndexIn(b, 6))
+	Equal(t, 15, m.LastIndexIn(b))
+	Equal(t, 4, m.CountIn(b))
+	Equal(t, "\u00E4\u6771\U0001F600", string(m.RemoveFrom(b)))
+	Equal(t, "\u3000 \u00A0 ", string(m.RetainFrom(b)))
+	Equal(t, "_\u00E4_\u6771_\U0001F600_", string(m.ReplaceFromRune(b, '_')))
+	Equal(t, "<>\u00E4<>\u6771<>\U0001F600<>", string(m.ReplaceFrom(b, []byte("<>"))))
+	Equal(t, "\u00E4 \u6771\u00A0\U0001F600", string(m.TrimFrom(b)))
+	Equal(t, "\u00E4 \u6771\u00A0\U0001F600 ", string(m.TrimLeadingFrom(b)))
+	Equal(t, "\u3000\u00E4 \u6771\u00A0\U0001F600", string(m.TrimTrailingFrom(b)))
+	Equal(t, "\u2013\u00E4\u2013\u6771\u2013\U0001F600\u2013", string(m.CollapseFrom(b, '\u2013')))
+	Equal(t, "\u00E4\u2013\u6771\u2013\U0001F600", string(m.TrimAndCollapseFrom(b, '\u2013')))
+}
+
+func TestRuneMatcher_InvalidUTF8(t *testing.T) {
+	m := UTF8(runematcher.Is('\uFFFD'))
+	b := []byte("a\xFFb\xE6\x9Dc")
+	Equal(t, 1, m.IndexIn(b, 0))
+	Equal(t, 4, m.LastIndexIn(b))
+	Equal(t, 3, m.CountIn(b))
+	Equal(t, "abc", string(m.RemoveFrom(b)))
+	Equal(t, "a\xFFb\xE6\x9Dc", string(UTF8(runematcher.Whitespace()).RemoveFrom(b)))
+}
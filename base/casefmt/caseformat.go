@@ -12,21 +12,28 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package casefmt provides utilities for converting between various ASCII case formats.
+// Package casefmt provides utilities for converting between various case formats.
 package casefmt
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/abc-inc/goava/base/runematcher"
 )
 
-// CaseFormat converts strings between various ASCII case formats.
+var (
+	upperCase = runematcher.UpperCase()
+	lowerCase = runematcher.LowerCase()
+)
+
+// CaseFormat converts strings between various case formats.
 //
-// Behavior is undefined for non-ASCII input.
+// Conversions are Unicode-aware, i.e., camel case words begin with an upper case letter as defined by Unicode, and
+// words are converted by means of the Unicode case mappings.
 type CaseFormat interface {
-	// wordBoundary matches the beginning of words.
-	wordBoundary() runematcher.Matcher
+	// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+	indexOfWordBoundary(s string, start int) int
 
 	// wordSeparator is a (potentially empty) string between two words.
 	wordSeparator() string
@@ -53,14 +60,14 @@ func convert(this CaseFormat, format CaseFormat, s string) string {
 		return s
 	}
 
-	runes := []rune(s)
 	var out strings.Builder
-	i := 0
-	for j := this.wordBoundary().IndexInRunes(runes, 0); j != -1; j = this.wordBoundary().IndexInRunes(runes, j+1) {
+	i, size := 0, 0
+	for j := this.indexOfWordBoundary(s, 0); j != -1; j = this.indexOfWordBoundary(s, j+size) {
+		_, size = utf8.DecodeRuneInString(s[j:])
 		if i == 0 {
 			out = strings.Builder{}
 			out.Grow(len(s) + 4*len(format.wordSeparator()))
-			out.WriteString(format.normalizeFirstWord(string(runes[i:j])))
+			out.WriteString(format.normalizeFirstWord(s[i:j]))
 		} else {
 			out.WriteString(format.normalizeWord(s[i:j]))
 		}
@@ -77,8 +84,75 @@ func convert(this CaseFormat, format CaseFormat, s string) string {
 }
 
 func firstCharOnlyToUpper(word string) string {
-	if len(word) == 0 {
-		return word
+	_, size := utf8.DecodeRuneInString(word)
+	return strings.ToUpper(word[:size]) + strings.ToLower(word[size:])
+}
+
+// capitalize formats a word of a camel case format, i.e., initialisms in upper case letters and other words with only
+// the first character in upper case. The plural of an initialism is written with a lower case "s", e.g., "IDs".
+// If initialisms is nil, GoInitialisms are used.
+func capitalize(word string, initialisms *Initialisms) string {
+	if initialisms == nil {
+		initialisms = GoInitialisms()
+	}
+	if initialisms.Contains(word) {
+		return strings.ToUpper(word)
+	}
+	if singular, ok := strings.CutSuffix(word, "s"); ok && initialisms.Contains(singular) {
+		return strings.ToUpper(singular) + "s"
+	}
+	return firstCharOnlyToUpper(word)
+}
+
+// indexOfCamelBoundary returns the byte offset of the first upper case letter at or after start, which begins a word
+// in a camel case format, or -1 if there is none. If initialisms is nil, GoInitialisms are used.
+//
+// An upper case letter begins a word, unless it follows another upper case letter and is not followed by a lower case
+// letter, i.e., the last upper case letter of an acronym begins a new word, if it is followed by a lower case letter.
+// However, the last upper case letter remains part of the acronym, if the acronym is an initialism and the following
+// lower case letters are either a plural "s" (e.g., "UserIDs") or a suffix like in "IPv6" or "SQLite", unless the
+// acronym without its last letter is an initialism as well (e.g., "HTTPServer").
+func indexOfCamelBoundary(s string, start int, initialisms *Initialisms) int {
+	if initialisms == nil {
+		initialisms = GoInitialisms()
+	}
+
+	size := 0
+	for j := upperCase.IndexIn(s, start); j != -1; j = upperCase.IndexIn(s, j+size) {
+		_, size = utf8.DecodeRuneInString(s[j:])
+		prev, _ := utf8.DecodeLastRuneInString(s[:j])
+		next, _ := utf8.DecodeRuneInString(s[j+size:])
+		if !upperCase.Matches(prev) {
+			return j
+		}
+		if lowerCase.Matches(next) && !continuesInitialism(s, j+size, initialisms) {
+			return j
+		}
+	}
+	return -1
+}
+
+// continuesInitialism returns true if the acronym ending at the byte offset end, which is followed by a lower case
+// letter, is an initialism including its last letter, i.e., the lower case letters do not begin a new word.
+func continuesInitialism(s string, end int, initialisms *Initialisms) bool {
+	begin := end
+	for begin > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:begin])
+		if !upperCase.Matches(r) {
+			break
+		}
+		begin -= size
+	}
+
+	acronym := s[begin:end]
+	if !initialisms.Contains(acronym) {
+		return false
+	}
+	if plural, ok := strings.CutPrefix(s[end:], "s"); ok {
+		if r, _ := utf8.DecodeRuneInString(plural); !lowerCase.Matches(r) {
+			return true
+		}
 	}
-	return strings.ToUpper(word[0:1]) + strings.ToLower(word[1:])
+	_, size := utf8.DecodeLastRuneInString(acronym)
+	return !initialisms.Contains(acronym[:len(acronym)-size])
 }
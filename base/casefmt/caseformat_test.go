@@ -23,7 +23,10 @@ import (
 )
 
 func TestIdentity(t *testing.T) {
-	tests := []CaseFormat{LowerCamel{}, LowerHyphen{}, LowerUnderscore{}, UpperCamel{}, UpperUnderscore{}}
+	tests := []CaseFormat{
+		Flat{}, LowerCamel{}, LowerDot{}, LowerHyphen{}, LowerSlash{}, LowerUnderscore{}, Sentence{}, Title{}, Train{},
+		UpperCamel{}, UpperHyphen{}, UpperUnderscore{},
+	}
 
 	for _, cf := range tests {
 		t.Run(reflect.TypeOf(cf).Name()+" to "+reflect.TypeOf(cf).Name(), func(t *testing.T) {
@@ -35,7 +38,10 @@ func TestIdentity(t *testing.T) {
 }
 
 func TestNilArgs(t *testing.T) {
-	tests := []CaseFormat{LowerCamel{}, LowerHyphen{}, LowerUnderscore{}, UpperCamel{}, UpperUnderscore{}}
+	tests := []CaseFormat{
+		Flat{}, LowerCamel{}, LowerDot{}, LowerHyphen{}, LowerSlash{}, LowerUnderscore{}, Sentence{}, Title{}, Train{},
+		UpperCamel{}, UpperHyphen{}, UpperUnderscore{},
+	}
 
 	for _, cf := range tests {
 		t.Run(reflect.TypeOf(cf).Name()+" to "+reflect.TypeOf(cf).Name(), func(t *testing.T) {
@@ -81,15 +87,15 @@ func Test(t *testing.T) {
 
 		{srcFmt: lCml, tgtFmt: lHyp, in: "foo", want: "foo"},
 		{srcFmt: lCml, tgtFmt: lHyp, in: "fooBar", want: "foo-bar"},
-		{srcFmt: lCml, tgtFmt: lHyp, in: "HTTP", want: "h-t-t-p"},
+		{srcFmt: lCml, tgtFmt: lHyp, in: "serveHTTP", want: "serve-http"},
 		{srcFmt: lCml, tgtFmt: lUnd, in: "foo", want: "foo"},
 		{srcFmt: lCml, tgtFmt: lUnd, in: "fooBar", want: "foo_bar"},
-		{srcFmt: lCml, tgtFmt: lUnd, in: "hTTP", want: "h_t_t_p"},
+		{srcFmt: lCml, tgtFmt: lUnd, in: "userID", want: "user_id"},
 		{srcFmt: lCml, tgtFmt: lCml, in: "foo", want: "foo"},
 		{srcFmt: lCml, tgtFmt: lCml, in: "fooBar", want: "fooBar"},
 		{srcFmt: lCml, tgtFmt: uCml, in: "foo", want: "Foo"},
 		{srcFmt: lCml, tgtFmt: uCml, in: "fooBar", want: "FooBar"},
-		{srcFmt: lCml, tgtFmt: uCml, in: "hTTP", want: "HTTP"},
+		{srcFmt: lCml, tgtFmt: uCml, in: "httpServerID", want: "HTTPServerID"},
 		{srcFmt: lCml, tgtFmt: uUnd, in: "foo", want: "FOO"},
 		{srcFmt: lCml, tgtFmt: uUnd, in: "fooBar", want: "FOO_BAR"},
 
@@ -99,12 +105,12 @@ func Test(t *testing.T) {
 		{srcFmt: uCml, tgtFmt: lUnd, in: "FooBar", want: "foo_bar"},
 		{srcFmt: uCml, tgtFmt: lCml, in: "Foo", want: "foo"},
 		{srcFmt: uCml, tgtFmt: lCml, in: "FooBar", want: "fooBar"},
-		{srcFmt: uCml, tgtFmt: lCml, in: "HTTP", want: "hTTP"},
+		{srcFmt: uCml, tgtFmt: lCml, in: "HTTPServer", want: "httpServer"},
 		{srcFmt: uCml, tgtFmt: uCml, in: "Foo", want: "Foo"},
 		{srcFmt: uCml, tgtFmt: uCml, in: "FooBar", want: "FooBar"},
 		{srcFmt: uCml, tgtFmt: uUnd, in: "Foo", want: "FOO"},
 		{srcFmt: uCml, tgtFmt: uUnd, in: "FooBar", want: "FOO_BAR"},
-		{srcFmt: uCml, tgtFmt: uUnd, in: "HTTP", want: "H_T_T_P"},
+		{srcFmt: uCml, tgtFmt: uUnd, in: "HTTP", want: "HTTP"},
 		{srcFmt: uCml, tgtFmt: uUnd, in: "H_T_T_P", want: "H__T__T__P"},
 
 		{srcFmt: uUnd, tgtFmt: lHyp, in: "FOO", want: "foo"},
@@ -126,3 +132,95 @@ func Test(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFormats(t *testing.T) {
+	tests := []struct {
+		srcFmt CaseFormat
+		tgtFmt CaseFormat
+		in     string
+		want   string
+	}{
+		{srcFmt: LowerCamel{}, tgtFmt: LowerDot{}, in: "serverPort", want: "server.port"},
+		{srcFmt: LowerDot{}, tgtFmt: UpperUnderscore{}, in: "server.port", want: "SERVER_PORT"},
+		{srcFmt: UpperUnderscore{}, tgtFmt: LowerSlash{}, in: "API_V2_USERS", want: "api/v2/users"},
+		{srcFmt: LowerSlash{}, tgtFmt: UpperCamel{}, in: "api/users", want: "APIUsers"},
+		{srcFmt: LowerHyphen{}, tgtFmt: Train{}, in: "content-type", want: "Content-Type"},
+		{srcFmt: Train{}, tgtFmt: UpperHyphen{}, in: "X-Request-Id", want: "X-REQUEST-ID"},
+		{srcFmt: UpperHyphen{}, tgtFmt: LowerCamel{}, in: "X-REQUEST-ID", want: "xRequestID"},
+		{srcFmt: LowerCamel{}, tgtFmt: Title{}, in: "createdAt", want: "Created At"},
+		{srcFmt: Title{}, tgtFmt: Sentence{}, in: "Created At", want: "Created at"},
+		{srcFmt: Sentence{}, tgtFmt: LowerUnderscore{}, in: "Created at", want: "created_at"},
+		{srcFmt: UpperCamel{}, tgtFmt: Flat{}, in: "ReadCloser", want: "readcloser"},
+		{srcFmt: Flat{}, tgtFmt: UpperCamel{}, in: "readcloser", want: "Readcloser"},
+	}
+
+	for _, tt := range tests {
+		t.Run(reflect.TypeOf(tt.srcFmt).Name()+"To"+reflect.TypeOf(tt.tgtFmt).Name()+"_"+tt.in, func(t *testing.T) {
+			Equal(t, tt.want, tt.srcFmt.To(tt.tgtFmt, tt.in))
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		in   string
+		want CaseFormat
+	}{
+		{"foo", Flat{}},
+		{"fooBar", LowerCamel{}},
+		{"FooBar", UpperCamel{}},
+		{"HTTPServer", UpperCamel{}},
+		{"FOO", UpperUnderscore{}},
+		{"foo_bar", LowerUnderscore{}},
+		{"FOO_BAR_2", UpperUnderscore{}},
+		{"foo-bar", LowerHyphen{}},
+		{"FOO-BAR", UpperHyphen{}},
+		{"Foo-Bar", Train{}},
+		{"foo.bar", LowerDot{}},
+		{"foo/bar", LowerSlash{}},
+		{"Foo Bar", Title{}},
+		{"Foo bar", Sentence{}},
+		{"\u00e4pfel\u00dcber", LowerCamel{}},
+	}
+
+	for _, tt := range tests {
+		cf, ok := Detect(tt.in)
+		True(t, ok, tt.in)
+		Equal(t, tt.want, cf, tt.in)
+	}
+
+	for _, in := range []string{"", "foo_bar-baz", "foo__bar", "_foo", "foo bar!", "Foo_Bar", "fOO-bAR", "foo Bar"} {
+		_, ok := Detect(in)
+		False(t, ok, in)
+	}
+}
+
+func TestInitialisms(t *testing.T) {
+	Equal(t, "http_server_id", UpperCamel{}.To(LowerUnderscore{}, "HTTPServerID"))
+	Equal(t, "HTTPServerID", LowerUnderscore{}.To(UpperCamel{}, "http_server_id"))
+	Equal(t, "xmlHTTPRequest", UpperCamel{}.To(LowerCamel{}, "XMLHttpRequest"))
+	Equal(t, "utf8-reader", UpperCamel{}.To(LowerHyphen{}, "UTF8Reader"))
+	Equal(t, "UTF8Reader", LowerHyphen{}.To(UpperCamel{}, "utf8-reader"))
+	Equal(t, "base64_encode", UpperCamel{}.To(LowerUnderscore{}, "Base64Encode"))
+	Equal(t, "user_ids", UpperCamel{}.To(LowerUnderscore{}, "UserIDs"))
+	Equal(t, "ids", UpperCamel{}.To(LowerUnderscore{}, "IDs"))
+	Equal(t, "ids", UpperCamel{}.To(LowerCamel{}, "IDs"))
+	Equal(t, "UserIDs", LowerUnderscore{}.To(UpperCamel{}, "user_ids"))
+	Equal(t, "userIDs", UpperCamel{}.To(LowerCamel{}, "UserIDs"))
+	Equal(t, "uids_by_name", UpperCamel{}.To(LowerUnderscore{}, "UIDsByName"))
+	Equal(t, "ipv6_addr", UpperCamel{}.To(LowerUnderscore{}, "IPv6Addr"))
+	Equal(t, "parse-ipv6", LowerCamel{}.To(LowerHyphen{}, "parseIPv6"))
+	Equal(t, "sqlite_driver", UpperCamel{}.To(LowerUnderscore{}, "SQLiteDriver"))
+	Equal(t, "http_session", UpperCamel{}.To(LowerUnderscore{}, "HTTPSession"))
+	Equal(t, "ui_document", UpperCamel{}.To(LowerUnderscore{}, "UIDocument"))
+	Equal(t, "io_error", UpperCamel{}.To(LowerUnderscore{}, "IOError"))
+
+	custom := UpperCamel{Initialisms: GoInitialisms().With("grpc")}
+	Equal(t, "GRPCServerID", LowerUnderscore{}.To(custom, "grpc_server_id"))
+	Equal(t, "HttpServerId", LowerUnderscore{}.To(UpperCamel{Initialisms: NewInitialisms()}, "http_server_id"))
+	Equal(t, "userURL", LowerHyphen{}.To(LowerCamel{Initialisms: NewInitialisms("url")}, "user-url"))
+
+	True(t, GoInitialisms().Contains("Id"))
+	False(t, GoInitialisms().Contains("GRPC"))
+	True(t, GoInitialisms().With("GRPC").Contains("gRPC"))
+}
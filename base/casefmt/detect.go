@@ -0,0 +1,92 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casefmt
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abc-inc/goava/base/runematcher"
+)
+
+var (
+	separators = runematcher.AnyOf("_-./ ")
+	wordChars  = runematcher.LetterOrDigit()
+)
+
+// Detect identifies the format of the given string, e.g., UpperUnderscore for "MAX_VALUE".
+// It returns false if the string does not conform to any of the formats in this package.
+//
+// A string consisting of a single word, e.g., "foo", conforms to several formats. In this case, one of them is
+// returned, which yields the same results as the others when converting the string to another format.
+func Detect(s string) (CaseFormat, bool) {
+	if len(s) == 0 || !wordChars.Or(separators).MatchesAllOf(s) {
+		return nil, false
+	}
+
+	sep := ""
+	if i := separators.IndexIn(s, 0); i >= 0 {
+		sep = s[i : i+1]
+	}
+	words := strings.Split(s, sep)
+	for _, w := range words {
+		if len(w) == 0 || separators.MatchesAnyOf(w) {
+			return nil, false
+		}
+	}
+
+	first, _ := utf8.DecodeRuneInString(s)
+	upper, lower := upperCase.MatchesAnyOf(s), lowerCase.MatchesAnyOf(s)
+	switch {
+	case sep == "" && upper && !lower:
+		return UpperUnderscore{}, true
+	case sep == "" && upperCase.Matches(first):
+		return UpperCamel{}, true
+	case sep == "" && upper:
+		return LowerCamel{}, true
+	case sep == "":
+		return Flat{}, true
+	case sep == "_" && !lower:
+		return UpperUnderscore{}, true
+	case sep == "_" && !upper:
+		return LowerUnderscore{}, true
+	case sep == "-" && !lower:
+		return UpperHyphen{}, true
+	case sep == "-" && !upper:
+		return LowerHyphen{}, true
+	case sep == "-" && capitalized(words...):
+		return Train{}, true
+	case sep == "." && !upper:
+		return LowerDot{}, true
+	case sep == "/" && !upper:
+		return LowerSlash{}, true
+	case sep == " " && capitalized(words...):
+		return Title{}, true
+	case sep == " " && capitalized(words[0]) && upperCase.MatchesNoneOf(s[len(words[0]):]):
+		return Sentence{}, true
+	}
+	return nil, false
+}
+
+// capitalized returns true if all words start with an upper case letter, which is followed by no other one.
+func capitalized(words ...string) bool {
+	for _, w := range words {
+		r, size := utf8.DecodeRuneInString(w)
+		if !upperCase.Matches(r) || upperCase.MatchesAnyOf(w[size:]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casefmt
+
+import (
+	"strings"
+
+	"github.com/abc-inc/goava/base/runematcher"
+)
+
+var wbFlat = runematcher.None()
+
+// Flat represents the naming convention without word separation, which is common for Go package names,
+// e.g., "flatcase".
+//
+// Since there are no word boundaries, a string in this format is always converted as a single word.
+type Flat struct{}
+
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c Flat) indexOfWordBoundary(s string, start int) int {
+	return wbFlat.IndexIn(s, start)
+}
+
+// wordSeparator is a (potentially empty) string between two words.
+func (c Flat) wordSeparator() string {
+	return ""
+}
+
+// normalizeWord formats a single word according to this case format.
+func (c Flat) normalizeWord(word string) string {
+	return strings.ToLower(word)
+}
+
+// normalizeFirstWord formats a single word, which is the first of the string, according to this case format.
+func (c Flat) normalizeFirstWord(word string) string {
+	return c.normalizeWord(word)
+}
+
+// To converts the specified string from this format to the specified format.
+//
+// A "best effort" approach is taken; if str does not conform to the assumed format, then the behavior is undefined
+// but we make a reasonable effort at converting anyway.
+func (c Flat) To(tgtFmt CaseFormat, str string) string {
+	return convert(c, tgtFmt, str)
+}
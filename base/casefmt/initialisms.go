@@ -0,0 +1,63 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casefmt
+
+import (
+	"strings"
+	"sync"
+)
+
+// Initialisms is an immutable set of words, which camel case formats write in upper case letters, e.g., "ID" in
+// "userID" instead of "userId".
+type Initialisms struct {
+	words map[string]struct{}
+}
+
+// goInitialisms contains the common initialisms as listed by the Go code review comments.
+var goInitialisms = sync.OnceValue(func() *Initialisms {
+	return NewInitialisms("ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+		"IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI",
+		"UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS")
+})
+
+// NewInitialisms returns a set of the given initialisms, which are matched case-insensitively.
+func NewInitialisms(words ...string) *Initialisms {
+	return (&Initialisms{}).With(words...)
+}
+
+// GoInitialisms returns the initialisms, which are commonly written in upper case letters in Go code, e.g., "ID",
+// "HTTP" and "URL" (see https://go.dev/wiki/CodeReviewComments#initialisms).
+func GoInitialisms() *Initialisms {
+	return goInitialisms()
+}
+
+// With returns a new set containing these initialisms as well as the given ones, e.g.,
+// GoInitialisms().With("GRPC").
+func (i *Initialisms) With(words ...string) *Initialisms {
+	set := &Initialisms{make(map[string]struct{}, len(i.words)+len(words))}
+	for w := range i.words {
+		set.words[w] = struct{}{}
+	}
+	for _, w := range words {
+		set.words[strings.ToUpper(w)] = struct{}{}
+	}
+	return set
+}
+
+// Contains returns true if the given word, regardless of its case, is one of these initialisms.
+func (i *Initialisms) Contains(word string) bool {
+	_, ok := i.words[strings.ToUpper(word)]
+	return ok
+}
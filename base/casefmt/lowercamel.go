@@ -14,22 +14,20 @@
 
 package casefmt
 
-import (
-	"strings"
+import "strings"
 
-	"github.com/abc-inc/goava/base/runematcher"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
-)
-
-var wbLowerCamel = runematcher.InRange('A', 'Z')
-
-// LowerCamel represents the Go variable naming convention, e.g., "lowerCamel".
-type LowerCamel struct{}
+// LowerCamel represents the Go variable naming convention, e.g., "lowerCamel" or "userID".
+//
+// Upper case letters begin a new word, unless they are part of an acronym, i.e., "HTTPServer" consists of the words
+// "HTTP" and "Server". Words, which are contained in Initialisms, are written in upper case letters.
+type LowerCamel struct {
+	// Initialisms are written in upper case letters, e.g., "ID" or "URL". If nil, GoInitialisms() are used.
+	Initialisms *Initialisms
+}
 
-// wordBoundary matches the beginning of words.
-func (c LowerCamel) wordBoundary() runematcher.Matcher {
-	return wbLowerCamel
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c LowerCamel) indexOfWordBoundary(s string, start int) int {
+	return indexOfCamelBoundary(s, start, c.Initialisms)
 }
 
 // wordSeparator is a (potentially empty) string between two words.
@@ -39,7 +37,7 @@ func (c LowerCamel) wordSeparator() string {
 
 // normalizeWord formats a single word according to this case format.
 func (c LowerCamel) normalizeWord(word string) string {
-	return firstCharOnlyToUpper(word)
+	return capitalize(word, c.Initialisms)
 }
 
 // normalizeFirstWord formats a single word, which is the first of the string, according to this case format.
@@ -52,8 +50,5 @@ func (c LowerCamel) normalizeFirstWord(word string) string {
 // A "best effort" approach is taken; if str does not conform to the assumed format, then the behavior is undefined,
 // but we make a reasonable effort at converting anyway.
 func (c LowerCamel) To(tgtFmt CaseFormat, str string) string {
-	if _, ok := tgtFmt.(UpperCamel); ok {
-		return cases.Title(language.Und, cases.NoLower).String(str)
-	}
 	return convert(c, tgtFmt, str)
 }
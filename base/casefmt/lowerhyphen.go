@@ -25,9 +25,9 @@ var wbLowerHyphen = runematcher.Is('-')
 // LowerHyphen represents the hyphenated variable naming convention, e.g., "lower-hyphen".
 type LowerHyphen struct{}
 
-// wordBoundary matches the beginning of words.
-func (c LowerHyphen) wordBoundary() runematcher.Matcher {
-	return wbLowerHyphen
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c LowerHyphen) indexOfWordBoundary(s string, start int) int {
+	return wbLowerHyphen.IndexIn(s, start)
 }
 
 // wordSeparator is a (potentially empty) string between two words.
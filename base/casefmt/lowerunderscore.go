@@ -25,9 +25,9 @@ var wbLowerUnderscore = runematcher.Is('_')
 // LowerUnderscore represents the C++ variable naming convention, e.g., "lower_underscore".
 type LowerUnderscore struct{}
 
-// wordBoundary matches the beginning of words.
-func (c LowerUnderscore) wordBoundary() runematcher.Matcher {
-	return wbLowerUnderscore
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c LowerUnderscore) indexOfWordBoundary(s string, start int) int {
+	return wbLowerUnderscore.IndexIn(s, start)
 }
 
 // wordSeparator is a (potentially empty) string between two words.
@@ -0,0 +1,164 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casefmt_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+	"unicode"
+	"unicode/utf8"
+
+	. "github.com/abc-inc/goava/base/casefmt"
+	. "github.com/stretchr/testify/require"
+)
+
+// letters contains lower case letters of different UTF-8 lengths, whose upper case variant maps back to them.
+var letters = func() []rune {
+	var ls []rune
+	for _, rt := range [][2]rune{{'a', 'z'}, {0xE0, 0xFE}, {0x3B1, 0x3C9}, {0x430, 0x44F}, {0x10428, 0x1044F}} {
+		for r := rt[0]; r <= rt[1]; r++ {
+			if u := unicode.ToUpper(r); unicode.IsLower(r) && unicode.IsUpper(u) && unicode.ToLower(u) == r {
+				ls = append(ls, r)
+			}
+		}
+	}
+	return ls
+}()
+
+// randomWords returns up to four random, lower case words, which start with two letters and may contain digits.
+// Camel case words starting with a single letter are ambiguous, e.g., "AB" is a single word.
+func randomWords(rnd *rand.Rand) []string {
+	words := make([]string, 1+rnd.Intn(4))
+	for i := range words {
+		word := []rune{letters[rnd.Intn(len(letters))], letters[rnd.Intn(len(letters))]}
+		for n := rnd.Intn(6); n > 0; n-- {
+			if rnd.Intn(5) == 0 {
+				word = append(word, '0'+rune(rnd.Intn(10)))
+			} else {
+				word = append(word, letters[rnd.Intn(len(letters))])
+			}
+		}
+		words[i] = string(word)
+	}
+	return words
+}
+
+// format is a reference implementation, which joins the given lower case words according to cf.
+func format(cf CaseFormat, words []string) string {
+	capitalize := func(w string) string {
+		r, size := utf8.DecodeRuneInString(w)
+		return string(unicode.ToUpper(r)) + w[size:]
+	}
+
+	out := make([]string, len(words))
+	for i, w := range words {
+		switch cf.(type) {
+		case LowerCamel:
+			out[i] = capitalize(w)
+			if i == 0 {
+				out[i] = w
+			}
+		case Sentence:
+			out[i] = w
+			if i == 0 {
+				out[i] = capitalize(w)
+			}
+		case UpperCamel, Train, Title:
+			out[i] = capitalize(w)
+		case UpperUnderscore, UpperHyphen:
+			out[i] = strings.ToUpper(w)
+		default:
+			out[i] = w
+		}
+	}
+
+	switch cf.(type) {
+	case LowerHyphen, UpperHyphen, Train:
+		return strings.Join(out, "-")
+	case LowerUnderscore, UpperUnderscore:
+		return strings.Join(out, "_")
+	case LowerDot:
+		return strings.Join(out, ".")
+	case LowerSlash:
+		return strings.Join(out, "/")
+	case Title, Sentence:
+		return strings.Join(out, " ")
+	default:
+		return strings.Join(out, "")
+	}
+}
+
+func TestReference(t *testing.T) {
+	// random words may happen to be initialisms, which are not supported by the reference implementation
+	none := NewInitialisms()
+	cfs := []CaseFormat{
+		Flat{}, LowerCamel{none}, LowerDot{}, LowerHyphen{}, LowerSlash{}, LowerUnderscore{}, Sentence{}, Title{},
+		Train{}, UpperCamel{none}, UpperHyphen{}, UpperUnderscore{},
+	}
+	cfg := &quick.Config{
+		MaxCount: 200,
+		Rand:     rand.New(rand.NewSource(42)),
+		Values: func(vs []reflect.Value, rnd *rand.Rand) {
+			vs[0] = reflect.ValueOf(randomWords(rnd))
+		},
+	}
+
+	for _, src := range cfs {
+		for _, tgt := range cfs {
+			t.Run(reflect.TypeOf(src).Name()+"To"+reflect.TypeOf(tgt).Name(), func(t *testing.T) {
+				NoError(t, quick.Check(func(words []string) bool {
+					if _, ok := src.(Flat); ok {
+						words = []string{strings.Join(words, "")}
+					}
+					return src.To(tgt, format(src, words)) == format(tgt, words)
+				}, cfg))
+			})
+		}
+	}
+
+	for _, src := range cfs {
+		t.Run("Detect"+reflect.TypeOf(src).Name(), func(t *testing.T) {
+			NoError(t, quick.Check(func(words []string) bool {
+				if _, ok := src.(Flat); ok {
+					words = []string{strings.Join(words, "")}
+				}
+				s := format(src, words)
+				cf, ok := Detect(s)
+				if !ok {
+					return false
+				}
+				for _, tgt := range cfs {
+					if cf.To(tgt, s) != format(tgt, words) {
+						return false
+					}
+				}
+				return true
+			}, cfg))
+		})
+	}
+}
+
+func TestUnicode(t *testing.T) {
+	Equal(t, "\u00e4pfel_\u00fcber_stra\u00dfe", LowerCamel{}.To(LowerUnderscore{}, "\u00e4pfel\u00dcberStra\u00dfe"))
+	Equal(t, "\u00c4pfel\u00dcber", LowerHyphen{}.To(UpperCamel{}, "\u00e4pfel-\u00fcber"))
+	Equal(t, "\u00e4pfel\u00dcber", UpperCamel{}.To(LowerCamel{}, "\u00c4pfel\u00dcber"))
+	Equal(t, "\u00c4pfel\u00dcber", LowerCamel{}.To(UpperCamel{}, "\u00e4pfel\u00dcber"))
+	Equal(t, "\u00c4PFEL_\u00dcBER", UpperCamel{}.To(UpperUnderscore{}, "\u00c4pfel\u00dcber"))
+	Equal(t, "\u0421\u043b\u043e\u0432\u043e", UpperUnderscore{}.To(UpperCamel{}, "\u0421\u041b\u041e\u0412\u041e"))
+	Equal(t, "\U00010428\U00010429-x", UpperCamel{}.To(LowerHyphen{}, "\U00010400\U00010429X"))
+}
@@ -14,20 +14,18 @@
 
 package casefmt
 
-import (
-	"strings"
-
-	"github.com/abc-inc/goava/base/runematcher"
-)
-
-var wbUpperCamel = runematcher.InRange('A', 'Z')
-
-// UpperCamel represents the Go export naming convention, e.g., "UpperCamel".
-type UpperCamel struct{}
+// UpperCamel represents the Go export naming convention, e.g., "UpperCamel" or "HTTPServer".
+//
+// Upper case letters begin a new word, unless they are part of an acronym, i.e., "HTTPServer" consists of the words
+// "HTTP" and "Server". Words, which are contained in Initialisms, are written in upper case letters.
+type UpperCamel struct {
+	// Initialisms are written in upper case letters, e.g., "ID" or "URL". If nil, GoInitialisms() are used.
+	Initialisms *Initialisms
+}
 
-// wordBoundary matches the beginning of words.
-func (c UpperCamel) wordBoundary() runematcher.Matcher {
-	return wbUpperCamel
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c UpperCamel) indexOfWordBoundary(s string, start int) int {
+	return indexOfCamelBoundary(s, start, c.Initialisms)
 }
 
 // wordSeparator is a (potentially empty) string between two words.
@@ -37,7 +35,7 @@ func (c UpperCamel) wordSeparator() string {
 
 // normalizeWord formats a single word according to this case format.
 func (c UpperCamel) normalizeWord(word string) string {
-	return firstCharOnlyToUpper(word)
+	return capitalize(word, c.Initialisms)
 }
 
 // normalizeFirstWord formats a single word, which is the first of the string, according to this case format.
@@ -50,8 +48,5 @@ func (c UpperCamel) normalizeFirstWord(word string) string {
 // A "best effort" approach is taken; if str does not conform to the assumed format, then the behavior is undefined
 // but we make a reasonable effort at converting anyway.
 func (c UpperCamel) To(tgtFmt CaseFormat, str string) string {
-	if _, ok := tgtFmt.(LowerCamel); ok && len(str) > 0 {
-		return strings.ToLower(str[:1]) + str[1:]
-	}
 	return convert(c, tgtFmt, str)
 }
@@ -25,9 +25,9 @@ var wbUpperUnderscore = runematcher.Is('_')
 // UpperUnderscore represents the Java and C++ constant naming convention, e.g., "UPPER_UNDERSCORE".
 type UpperUnderscore struct{}
 
-// wordBoundary matches the beginning of words.
-func (c UpperUnderscore) wordBoundary() runematcher.Matcher {
-	return wbUpperUnderscore
+// indexOfWordBoundary returns the byte offset of the first word boundary at or after start, or -1 if there is none.
+func (c UpperUnderscore) indexOfWordBoundary(s string, start int) int {
+	return wbUpperUnderscore.IndexIn(s, start)
 }
 
 // wordSeparator is a (potentially empty) string between two words.
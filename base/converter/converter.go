@@ -0,0 +1,101 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package converter provides functions for converting back and forth between different representations of the same
+// information, e.g., between names and values of an enumeration.
+//
+// A Converter consists of a forward function from A to B and a backward function from B to A, which are expected to
+// be inverse to each other, i.e., converting a value forth and back again yields the original value.
+package converter
+
+import (
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// Converter is a function from A to B with an associated reverse function from B to A.
+//
+// The zero value is not usable. Converters are created by From, FromFallible and the other functions of this package.
+type Converter[A, B any] struct {
+	forward  func(A) (B, error)
+	backward func(B) (A, error)
+}
+
+// From returns a Converter based on the given forward and backward functions, which never fail.
+//
+// For example, From(strings.ToUpper, strings.ToLower) converts lower case strings to upper case strings and back.
+func From[A, B any](f func(A) B, g func(B) A) Converter[A, B] {
+	return FromFallible(
+		func(a A) (B, error) { return f(a), nil },
+		func(b B) (A, error) { return g(b), nil },
+	)
+}
+
+// FromFallible returns a Converter based on the given forward and backward functions, which may fail, e.g., because
+// the input cannot be parsed.
+func FromFallible[A, B any](f func(A) (B, error), g func(B) (A, error)) Converter[A, B] {
+	return Converter[A, B]{f, g}
+}
+
+// Identity returns a Converter that always converts or reverses a value to itself.
+func Identity[T any]() Converter[T, T] {
+	return From(func(t T) T { return t }, func(t T) T { return t })
+}
+
+// AndThen returns a Converter whose Convert method applies second to the result of first. Its Reverse converts in
+// the opposite direction, i.e., it applies the reverse of first to the result of the reverse of second.
+func AndThen[A, B, C any](first Converter[A, B], second Converter[B, C]) Converter[A, C] {
+	return FromFallible(
+		func(a A) (C, error) {
+			b, err := first.forward(a)
+			if err != nil {
+				var c C
+				return c, err
+			}
+			return second.forward(b)
+		},
+		func(c C) (A, error) {
+			b, err := second.backward(c)
+			if err != nil {
+				var a A
+				return a, err
+			}
+			return first.backward(b)
+		},
+	)
+}
+
+// Convert returns a representation of a as an instance of type B.
+func (c Converter[A, B]) Convert(a A) (B, error) {
+	return c.forward(a)
+}
+
+// ConvertAll returns a slice containing the results of converting all values of as.
+//
+// If a value cannot be converted, it returns an error, which refers to the index of the value and wraps the cause.
+func (c Converter[A, B]) ConvertAll(as []A) ([]B, error) {
+	bs := make([]B, len(as))
+	for i, a := range as {
+		b, err := c.forward(a)
+		if err != nil {
+			return nil, precond.CheckArgumentWrapf(false, err, "cannot convert element %d", i)
+		}
+		bs[i] = b
+	}
+	return bs, nil
+}
+
+// Reverse returns the reversed view of this Converter, which converts B to A.
+func (c Converter[A, B]) Reverse() Converter[B, A] {
+	return Converter[B, A]{c.backward, c.forward}
+}
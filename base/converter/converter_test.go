@@ -0,0 +1,102 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/abc-inc/goava/base/converter"
+	"github.com/abc-inc/goava/base/precond"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestFrom(t *testing.T) {
+	c := From(strings.ToUpper, strings.ToLower)
+	s, err := c.Convert("foo")
+	NoError(t, err)
+	Equal(t, "FOO", s)
+
+	s, err = c.Reverse().Convert("BAR")
+	NoError(t, err)
+	Equal(t, "bar", s)
+
+	s, err = c.Reverse().Reverse().Convert("baz")
+	NoError(t, err)
+	Equal(t, "BAZ", s)
+}
+
+func TestFromFallible(t *testing.T) {
+	c := FromFallible(strconv.Atoi, func(i int) (string, error) { return strconv.Itoa(i), nil })
+	i, err := c.Convert("42")
+	NoError(t, err)
+	Equal(t, 42, i)
+
+	_, err = c.Convert("x")
+	ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestIdentity(t *testing.T) {
+	c := Identity[string]()
+	s, err := c.Convert("foo")
+	NoError(t, err)
+	Equal(t, "foo", s)
+
+	s, err = c.Reverse().Convert("bar")
+	NoError(t, err)
+	Equal(t, "bar", s)
+}
+
+func TestAndThen(t *testing.T) {
+	c := AndThen(Int[int](), From(func(i int) int { return i * 2 }, func(i int) int { return i / 2 }))
+	i, err := c.Convert("21")
+	NoError(t, err)
+	Equal(t, 42, i)
+
+	s, err := c.Reverse().Convert(84)
+	NoError(t, err)
+	Equal(t, "42", s)
+
+	_, err = c.Convert("x")
+	ErrorIs(t, err, strconv.ErrSyntax)
+
+	failing := FromFallible(
+		func(i int) (int, error) { return 0, errors.New("forward") },
+		func(i int) (int, error) { return 0, errors.New("backward") },
+	)
+	_, err = AndThen(Identity[int](), failing).Convert(1)
+	EqualError(t, err, "forward")
+	_, err = AndThen(failing, Identity[int]()).Reverse().Convert(1)
+	EqualError(t, err, "backward")
+}
+
+func TestConvertAll(t *testing.T) {
+	is, err := Int[int]().ConvertAll([]string{"1", "-2", "3"})
+	NoError(t, err)
+	Equal(t, []int{1, -2, 3}, is)
+
+	is, err = Int[int]().ConvertAll(nil)
+	NoError(t, err)
+	Empty(t, is)
+
+	_, err = Int[int]().ConvertAll([]string{"1", "x"})
+	EqualError(t, err, "cannot convert element 1")
+	EqualError(t, errors.Unwrap(err), `cannot parse "x" as int`)
+	ErrorIs(t, err, strconv.ErrSyntax)
+	ErrorIs(t, err, precond.ErrIllegalArgument)
+	Regexp(t, `/base/converter/converter_test\.go:\d+$`, err.(interface{ Caller() string }).Caller())
+}
@@ -0,0 +1,156 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/abc-inc/goava/base/casefmt"
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// signed is a constraint that permits any signed integer type.
+type signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// unsigned is a constraint that permits any unsigned integer type.
+type unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// float is a constraint that permits any floating-point type.
+type float interface {
+	~float32 | ~float64
+}
+
+// CaseFormat returns a Converter, which converts strings from the src format to the tgt format, and back.
+//
+// For example, CaseFormat(casefmt.UpperCamel{}, casefmt.LowerUnderscore{}) converts Go field names such as
+// "CreatedAt" to SQL column names such as "created_at".
+func CaseFormat(src, tgt casefmt.CaseFormat) Converter[string, string] {
+	return From(
+		func(s string) string { return src.To(tgt, s) },
+		func(s string) string { return tgt.To(src, s) },
+	)
+}
+
+// Int returns a Converter, which parses decimal strings into signed integers and formats them back.
+// Strings, which are not valid numbers or out of range for T, cannot be converted.
+func Int[T signed]() Converter[string, T] {
+	bitSize := reflect.TypeOf(T(0)).Bits()
+	return FromFallible(
+		func(s string) (T, error) {
+			i, err := strconv.ParseInt(s, 10, bitSize)
+			return parsed(s, T(i), err)
+		},
+		func(t T) (string, error) { return strconv.FormatInt(int64(t), 10), nil },
+	)
+}
+
+// Uint returns a Converter, which parses decimal strings into unsigned integers and formats them back.
+// Strings, which are not valid numbers or out of range for T, cannot be converted.
+func Uint[T unsigned]() Converter[string, T] {
+	bitSize := reflect.TypeOf(T(0)).Bits()
+	return FromFallible(
+		func(s string) (T, error) {
+			u, err := strconv.ParseUint(s, 10, bitSize)
+			return parsed(s, T(u), err)
+		},
+		func(t T) (string, error) { return strconv.FormatUint(uint64(t), 10), nil },
+	)
+}
+
+// Float returns a Converter, which parses strings into floating-point numbers and formats them back using the
+// smallest number of digits necessary to represent the value exactly.
+func Float[T float]() Converter[string, T] {
+	bitSize := reflect.TypeOf(T(0)).Bits()
+	return FromFallible(
+		func(s string) (T, error) {
+			f, err := strconv.ParseFloat(s, bitSize)
+			return parsed(s, T(f), err)
+		},
+		func(t T) (string, error) { return strconv.FormatFloat(float64(t), 'g', -1, bitSize), nil },
+	)
+}
+
+// Bool returns a Converter, which parses strings into boolean values (see strconv.ParseBool) and formats them back.
+func Bool() Converter[string, bool] {
+	return FromFallible(
+		func(s string) (bool, error) {
+			b, err := strconv.ParseBool(s)
+			return parsed(s, b, err)
+		},
+		func(b bool) (string, error) { return strconv.FormatBool(b), nil },
+	)
+}
+
+// parsed returns the result of parsing s, or an error wrapping the parse error, if any.
+func parsed[T any](s string, t T, err error) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, precond.CheckArgumentWrapf(false, err, "cannot parse %q as %T", s, zero)
+	}
+	return t, nil
+}
+
+// Enum returns a Converter between the names of the given values, as returned by their String method, and the values
+// themselves. It returns an error if two distinct values have the same name.
+//
+// For example, Enum(time.Saturday, time.Sunday) converts "Sunday" to time.Sunday and back.
+func Enum[E interface {
+	comparable
+	fmt.Stringer
+}](values ...E) (Converter[string, E], error) {
+	names := make(map[string]E, len(values))
+	for _, v := range values {
+		prev, dup := names[v.String()]
+		err := precond.CheckArgumentf(!dup || prev == v, "duplicate name: %q", v.String())
+		if err != nil {
+			return Converter[string, E]{}, err
+		}
+		names[v.String()] = v
+	}
+	return EnumMap(names)
+}
+
+// EnumMap returns a Converter between the names of an enumeration and its values, as defined by names.
+// It returns an error if two names refer to the same value.
+func EnumMap[E comparable](names map[string]E) (Converter[string, E], error) {
+	byName := make(map[string]E, len(names))
+	byValue := make(map[E]string, len(names))
+	for n, v := range names {
+		prev, dup := byValue[v]
+		err := precond.CheckArgumentf(!dup, "names %q and %q refer to the same value: %v", min(prev, n), max(prev, n), v)
+		if err != nil {
+			return Converter[string, E]{}, err
+		}
+		byName[n] = v
+		byValue[v] = n
+	}
+
+	return FromFallible(
+		func(n string) (E, error) {
+			v, ok := byName[n]
+			return v, precond.CheckArgumentf(ok, "unknown name: %q", n)
+		},
+		func(v E) (string, error) {
+			n, ok := byValue[v]
+			return n, precond.CheckArgumentf(ok, "unknown value: %v", v)
+		},
+	), nil
+}
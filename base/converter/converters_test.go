@@ -0,0 +1,144 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/base/casefmt"
+	. "github.com/abc-inc/goava/base/converter"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestCaseFormat(t *testing.T) {
+	c := CaseFormat(casefmt.UpperCamel{}, casefmt.LowerUnderscore{})
+	s, err := c.Convert("HTTPServerID")
+	NoError(t, err)
+	Equal(t, "http_server_id", s)
+
+	s, err = c.Reverse().Convert("created_at")
+	NoError(t, err)
+	Equal(t, "CreatedAt", s)
+
+	ss, err := c.ConvertAll([]string{"ID", "UserName"})
+	NoError(t, err)
+	Equal(t, []string{"id", "user_name"}, ss)
+}
+
+func TestInt(t *testing.T) {
+	i, err := Int[int8]().Convert("-128")
+	NoError(t, err)
+	Equal(t, int8(math.MinInt8), i)
+
+	_, err = Int[int8]().Convert("128")
+	EqualError(t, err, `cannot parse "128" as int8`)
+	ErrorIs(t, err, strconv.ErrRange)
+
+	s, err := Int[int64]().Reverse().Convert(math.MaxInt64)
+	NoError(t, err)
+	Equal(t, "9223372036854775807", s)
+
+	d, err := Int[time.Duration]().Convert("1000")
+	NoError(t, err)
+	Equal(t, time.Microsecond, d)
+}
+
+func TestUint(t *testing.T) {
+	u, err := Uint[uint16]().Convert("65535")
+	NoError(t, err)
+	Equal(t, uint16(math.MaxUint16), u)
+
+	_, err = Uint[uint]().Convert("-1")
+	EqualError(t, err, `cannot parse "-1" as uint`)
+
+	s, err := Uint[uint64]().Reverse().Convert(math.MaxUint64)
+	NoError(t, err)
+	Equal(t, "18446744073709551615", s)
+}
+
+func TestFloat(t *testing.T) {
+	f, err := Float[float32]().Convert("0.1")
+	NoError(t, err)
+	Equal(t, float32(0.1), f)
+
+	s, err := Float[float32]().Reverse().Convert(0.1)
+	NoError(t, err)
+	Equal(t, "0.1", s)
+
+	s, err = Float[float64]().Reverse().Convert(1e21)
+	NoError(t, err)
+	Equal(t, "1e+21", s)
+
+	_, err = Float[float64]().Convert("1,5")
+	EqualError(t, err, `cannot parse "1,5" as float64`)
+}
+
+func TestBool(t *testing.T) {
+	b, err := Bool().Convert("TRUE")
+	NoError(t, err)
+	True(t, b)
+
+	s, err := Bool().Reverse().Convert(false)
+	NoError(t, err)
+	Equal(t, "false", s)
+
+	_, err = Bool().Convert("yes")
+	EqualError(t, err, `cannot parse "yes" as bool`)
+}
+
+func TestEnum(t *testing.T) {
+	c, err := Enum(time.Saturday, time.Sunday, time.Sunday)
+	NoError(t, err)
+
+	d, err := c.Convert("Sunday")
+	NoError(t, err)
+	Equal(t, time.Sunday, d)
+
+	s, err := c.Reverse().Convert(time.Saturday)
+	NoError(t, err)
+	Equal(t, "Saturday", s)
+
+	_, err = c.Convert("Monday")
+	EqualError(t, err, `unknown name: "Monday"`)
+
+	_, err = c.Reverse().Convert(time.Monday)
+	EqualError(t, err, "unknown value: Monday")
+
+	_, err = Enum(level(1), level(2))
+	EqualError(t, err, `duplicate name: "level"`)
+}
+
+type level int
+
+func (level) String() string { return "level" }
+
+func TestEnumMap(t *testing.T) {
+	c, err := EnumMap(map[string]int{"low": 1, "high": 3})
+	NoError(t, err)
+
+	i, err := c.Convert("high")
+	NoError(t, err)
+	Equal(t, 3, i)
+
+	s, err := c.Reverse().Convert(1)
+	NoError(t, err)
+	Equal(t, "low", s)
+
+	_, err = EnumMap(map[string]int{"low": 1, "high": 3, "min": 1})
+	EqualError(t, err, `names "low" and "min" refer to the same value: 1`)
+}
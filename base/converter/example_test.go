@@ -0,0 +1,42 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter_test
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/base/casefmt"
+	"github.com/abc-inc/goava/base/converter"
+)
+
+func Example() {
+	c := converter.CaseFormat(casefmt.UpperCamel{}, casefmt.UpperUnderscore{})
+	env, _ := c.Convert("ServerURL")
+	field, _ := c.Reverse().Convert("MAX_IDLE_CONNS")
+	fmt.Println(env, field)
+	// Output: SERVER_URL MaxIdleConns
+}
+
+func ExampleAndThen() {
+	c := converter.AndThen(converter.Int[int](), converter.From(
+		func(celsius int) float64 { return float64(celsius)*1.8 + 32 },
+		func(fahrenheit float64) int { return int((fahrenheit - 32) / 1.8) },
+	))
+
+	fs, _ := c.ConvertAll([]string{"-40", "100"})
+	s, _ := c.Reverse().Convert(50)
+	fmt.Println(fs, s)
+	// Output: [-40 212] 10
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joiner_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abc-inc/goava/base/joiner"
+)
+
+func Example() {
+	j := joiner.On("; ").SkipNils()
+	s, err := j.Join("Harry", nil, "Ron", "Hermione")
+	fmt.Println(s, err)
+
+	_ = joiner.On(", ").UseForNil("?").AppendTo(os.Stdout, 1, nil, 3)
+	fmt.Println()
+	// Output:
+	// Harry; Ron; Hermione <nil>
+	// 1, ?, 3
+}
+
+func ExampleJoinMap() {
+	mj, _ := joiner.On("&").WithKeyValueSeparator("=")
+	s, _ := joiner.JoinMap(mj, map[string]int{"page": 2, "size": 10, "offset": 20})
+	fmt.Println(s)
+	// Output:
+	// offset=20&page=2&size=10
+}
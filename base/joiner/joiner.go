@@ -0,0 +1,187 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package joiner joins pieces of text (specified as an array, slice, iterator or map) with a separator.
+//
+// It either appends the results to an io.Writer, or returns them as a string. For example:
+//
+//	j := joiner.On("; ").SkipNils()
+//	s, err := j.Join("Harry", nil, "Ron", "Hermione")
+//
+// This returns the string "Harry; Ron; Hermione". Elements implementing fmt.Stringer are converted by their String
+// method, whereas other elements are formatted like fmt.Sprint does.
+//
+// If neither SkipNils nor UseForNil is specified, the joining functions return an error if any given element is nil.
+//
+// Joiner instances are immutable. Invoking a configuration method has no effect on the receiving instance; it returns
+// a new joiner instance instead.
+package joiner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/internal/reflectutil"
+)
+
+// Iterator provides the elements to join one by one.
+// Next returns the next element and true, or false if there are no more elements.
+type Iterator[T any] interface {
+	Next() (T, bool)
+}
+
+// Joiner joins pieces of text with a separator.
+type Joiner struct {
+	sep      string
+	skipNils bool
+	nilText  *string
+}
+
+// On returns a joiner which automatically places sep between consecutive elements.
+func On(sep string) Joiner {
+	return Joiner{sep: sep}
+}
+
+// OnRune returns a joiner which automatically places sep between consecutive elements.
+func OnRune(sep rune) Joiner {
+	return On(string(sep))
+}
+
+// SkipNils returns a joiner with the same behavior as this joiner, except automatically skipping over any provided
+// nil elements.
+func (j Joiner) SkipNils() Joiner {
+	j.skipNils, j.nilText = true, nil
+	return j
+}
+
+// UseForNil returns a joiner with the same behavior as this one, except automatically substituting nilText for any
+// provided nil elements.
+func (j Joiner) UseForNil(nilText string) Joiner {
+	j.skipNils, j.nilText = false, &nilText
+	return j
+}
+
+// Join returns a string containing the string representation of each of parts, using the previously configured
+// separator between each.
+func (j Joiner) Join(parts ...interface{}) (string, error) {
+	sb := &strings.Builder{}
+	if err := j.AppendTo(sb, parts...); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// JoinStrings returns a string containing parts, using the previously configured separator between each.
+func (j Joiner) JoinStrings(parts ...string) string {
+	return strings.Join(parts, j.sep)
+}
+
+// AppendTo appends the string representation of each of parts, using the previously configured separator between
+// each, to w.
+func (j Joiner) AppendTo(w io.Writer, parts ...interface{}) error {
+	return AppendIteratorTo[interface{}](j, w, &sliceIterator[interface{}]{s: parts})
+}
+
+// JoinSlice returns a string containing the string representation of each of parts, using the separator of j between
+// each.
+func JoinSlice[T any](j Joiner, parts []T) (string, error) {
+	return JoinIterator[T](j, &sliceIterator[T]{s: parts})
+}
+
+// JoinIterator returns a string containing the string representation of each of the elements provided by it, using
+// the separator of j between each.
+func JoinIterator[T any](j Joiner, it Iterator[T]) (string, error) {
+	sb := &strings.Builder{}
+	if err := AppendIteratorTo(j, sb, it); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// AppendSliceTo appends the string representation of each of parts, using the separator of j between each, to w.
+func AppendSliceTo[T any](j Joiner, w io.Writer, parts []T) error {
+	return AppendIteratorTo[T](j, w, &sliceIterator[T]{s: parts})
+}
+
+// AppendIteratorTo appends the string representation of each of the elements provided by it, using the separator of j
+// between each, to w.
+//
+// If an element is nil and the joiner neither skips nor substitutes nil elements, an error is returned. In this case,
+// the elements preceding the nil element have already been appended to w.
+func AppendIteratorTo[T any](j Joiner, w io.Writer, it Iterator[T]) error {
+	first := true
+	for i := 0; ; i++ {
+		p, ok := it.Next()
+		if !ok {
+			return nil
+		}
+		s, skip, err := j.toString(p, "element at index %d is nil", i)
+		if err != nil {
+			return err
+		} else if skip {
+			continue
+		}
+
+		if !first {
+			if _, err := io.WriteString(w, j.sep); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, s); err != nil {
+			return err
+		}
+		first = false
+	}
+}
+
+// toString returns the string representation of p, or whether p shall be skipped because it is nil.
+func (j Joiner) toString(p interface{}, desc string, args ...interface{}) (s string, skip bool, err error) {
+	if reflectutil.IsNil(p) {
+		switch {
+		case j.skipNils:
+			return "", true, nil
+		case j.nilText != nil:
+			return *j.nilText, false, nil
+		default:
+			_, err := precond.CheckNotNilf(p, desc, args...)
+			return "", false, err
+		}
+	}
+
+	switch v := p.(type) {
+	case string:
+		return v, false, nil
+	case fmt.Stringer:
+		return v.String(), false, nil
+	default:
+		return fmt.Sprint(v), false, nil
+	}
+}
+
+// sliceIterator iterates over the elements of a slice.
+type sliceIterator[T any] struct {
+	s []T
+	i int
+}
+
+func (it *sliceIterator[T]) Next() (T, bool) {
+	if it.i >= len(it.s) {
+		var zero T
+		return zero, false
+	}
+	it.i++
+	return it.s[it.i-1], true
+}
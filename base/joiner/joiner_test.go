@@ -0,0 +1,108 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joiner_test
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/abc-inc/goava/base/joiner"
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/base/splitter"
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+	. "github.com/stretchr/testify/require"
+)
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+var j = joiner.On("-")
+
+func TestJoiner_Join(t *testing.T) {
+	Equal(t, "", fst(j.Join()))
+	Equal(t, "1", fst(j.Join(1)))
+	Equal(t, "1-2-3", fst(j.Join(1, "2", 3.0)))
+	Equal(t, "a--b", fst(j.Join("a", "", "b")))
+	Equal(t, "1.2.3.4-EOF", fst(j.Join(net.IPv4(1, 2, 3, 4), errors.New("EOF"))))
+	Equal(t, "a,b", fst(joiner.OnRune(',').Join("a", "b")))
+}
+
+func TestJoiner_Join_Nil(t *testing.T) {
+	var p *net.IP
+	_, err := j.Join(1, nil, 3)
+	EqualError(t, err, "element at index 1 is nil")
+	ErrorIs(t, err, precond.ErrNil)
+
+	_, err = j.Join(1, 2, p)
+	EqualError(t, err, "element at index 2 is nil")
+
+	Equal(t, "1-3", fst(j.SkipNils().Join(nil, 1, nil, p, 3, nil)))
+	Equal(t, "", fst(j.SkipNils().Join(nil)))
+	Equal(t, "1-<nil>-3", fst(j.UseForNil("<nil>").Join(1, nil, 3)))
+	Equal(t, "1--3", fst(j.SkipNils().UseForNil("").Join(1, p, 3)))
+	Equal(t, "1-3", fst(j.UseForNil("x").SkipNils().Join(1, p, 3)))
+}
+
+func TestJoiner_JoinStrings(t *testing.T) {
+	Equal(t, "a-b-c", j.JoinStrings("a", "b", "c"))
+	Equal(t, "", j.JoinStrings())
+}
+
+func TestJoinSlice(t *testing.T) {
+	Equal(t, "1-2-3", fst(joiner.JoinSlice(j, []int{1, 2, 3})))
+	Equal(t, "", fst(joiner.JoinSlice(j, []int(nil))))
+
+	one := 1
+	_, err := joiner.JoinSlice(j, []*int{&one, nil})
+	EqualError(t, err, "element at index 1 is nil")
+}
+
+func TestJoinIterator(t *testing.T) {
+	Equal(t, "a-b-c", fst(joiner.JoinIterator[string](j, splitter.OnRune(',').Split("a,b,c"))))
+
+	cs := ranges.ClosedContiguousSet(1, 4, domain.Int{})
+	Equal(t, "1, 2, 3, 4", fst(joiner.JoinIterator[int](joiner.On(", "), cs.Iterator())))
+}
+
+func TestJoiner_AppendTo(t *testing.T) {
+	sb := &strings.Builder{}
+	sb.WriteString("x:")
+	NoError(t, j.AppendTo(sb, "a", 1))
+	Equal(t, "x:a-1", sb.String())
+
+	sb.Reset()
+	EqualError(t, j.AppendTo(sb, "a", nil, "b"), "element at index 1 is nil")
+	Equal(t, "a", sb.String())
+
+	sb.Reset()
+	NoError(t, joiner.AppendSliceTo(j, sb, []string{"a", "b"}))
+	Equal(t, "a-b", sb.String())
+
+	EqualError(t, j.AppendTo(failingWriter{}, "a"), "write failed")
+	NoError(t, j.AppendTo(failingWriter{}))
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
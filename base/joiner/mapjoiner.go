@@ -0,0 +1,113 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joiner
+
+import (
+	"cmp"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// MapJoiner joins map entries, i.e., keys and values, with separators between entries as well as between keys and
+// values.
+type MapJoiner struct {
+	j     Joiner
+	kvSep string
+}
+
+// WithKeyValueSeparator returns a MapJoiner using the given key-value separator, and the same configuration as this
+// joiner otherwise.
+//
+// An error is returned if this joiner skips nil elements, because the entries of a map cannot be skipped partially.
+func (j Joiner) WithKeyValueSeparator(kvSep string) (MapJoiner, error) {
+	if err := precond.CheckStatef(!j.skipNils, "cannot use SkipNils() with maps"); err != nil {
+		return MapJoiner{}, err
+	}
+	return MapJoiner{j, kvSep}, nil
+}
+
+// UseForNil returns a map joiner with the same behavior as this one, except automatically substituting nilText for any
+// provided nil keys or values.
+func (mj MapJoiner) UseForNil(nilText string) MapJoiner {
+	mj.j = mj.j.UseForNil(nilText)
+	return mj
+}
+
+// JoinMap returns a string containing the string representation of each entry of m, using the separators of mj.
+// The entries are ordered by their keys.
+func JoinMap[K cmp.Ordered, V any](mj MapJoiner, m map[K]V) (string, error) {
+	return JoinMapFunc(mj, m, cmp.Compare[K])
+}
+
+// JoinMapFunc returns a string containing the string representation of each entry of m, using the separators of mj.
+// The entries are ordered by their keys according to the given comparison function.
+func JoinMapFunc[K comparable, V any](mj MapJoiner, m map[K]V, cmp func(a, b K) int) (string, error) {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return cmp(keys[i], keys[j]) < 0 })
+
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return JoinEntries(mj, keys, values)
+}
+
+// JoinEntries returns a string containing the string representation of each entry, using the separators of mj.
+// The entries are given by keys and values in insertion order, i.e., the key at index i belongs to the value at index
+// i. An error is returned if their lengths differ.
+func JoinEntries[K, V any](mj MapJoiner, keys []K, values []V) (string, error) {
+	sb := &strings.Builder{}
+	if err := AppendEntriesTo(mj, sb, keys, values); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// AppendEntriesTo appends the string representation of each entry, using the separators of mj, to w.
+// The entries are given by keys and values in insertion order, i.e., the key at index i belongs to the value at index
+// i. An error is returned if their lengths differ.
+func AppendEntriesTo[K, V any](mj MapJoiner, w io.Writer, keys []K, values []V) error {
+	err := precond.CheckArgumentf(len(keys) == len(values),
+		"number of keys (%d) must be equal to number of values (%d)", len(keys), len(values))
+	if err != nil {
+		return err
+	}
+
+	for i := range keys {
+		k, _, err := mj.j.toString(keys[i], "key at index %d is nil", i)
+		if err != nil {
+			return err
+		}
+		v, _, err := mj.j.toString(values[i], "value for key %s is nil", k)
+		if err != nil {
+			return err
+		}
+
+		sep := mj.j.sep
+		if i == 0 {
+			sep = ""
+		}
+		if _, err := io.WriteString(w, sep+k+mj.kvSep+v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
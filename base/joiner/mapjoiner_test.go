@@ -0,0 +1,76 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joiner_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abc-inc/goava/base/joiner"
+	"github.com/abc-inc/goava/base/splitter"
+	. "github.com/stretchr/testify/require"
+)
+
+var mj = fst(joiner.On("&").WithKeyValueSeparator("="))
+
+func TestMapJoiner_JoinMap(t *testing.T) {
+	Equal(t, "", fst(joiner.JoinMap(mj, map[string]int{})))
+	Equal(t, "a=1", fst(joiner.JoinMap(mj, map[string]int{"a": 1})))
+	Equal(t, "a=1&b=2&c=3", fst(joiner.JoinMap(mj, map[string]int{"c": 3, "a": 1, "b": 2})))
+	Equal(t, "1=x&2=y&10=z", fst(joiner.JoinMap(mj, map[int]string{10: "z", 2: "y", 1: "x"})))
+}
+
+func TestMapJoiner_JoinMapFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	Equal(t, "a=1&bb=2&ccc=3", fst(joiner.JoinMapFunc(mj, map[string]int{"ccc": 3, "a": 1, "bb": 2}, byLen)))
+}
+
+func TestMapJoiner_JoinEntries(t *testing.T) {
+	ms := splitter.OnRune('&').WithKeyValueSeparatorRune('=')
+	keys, values := fst2(ms.SplitToEntries("z=1&a=2&m=3"))
+	Equal(t, "z=1&a=2&m=3", fst(joiner.JoinEntries(mj, keys, values)))
+
+	_, err := joiner.JoinEntries(mj, []string{"a"}, []int{})
+	EqualError(t, err, "number of keys (1) must be equal to number of values (0)")
+}
+
+func fst2[K, V any](k K, v V, err error) (K, V) {
+	if err != nil {
+		panic(err)
+	}
+	return k, v
+}
+
+func TestMapJoiner_Nil(t *testing.T) {
+	_, err := joiner.JoinMap(mj, map[string]*int{"a": nil})
+	EqualError(t, err, "value for key a is nil")
+
+	_, err = joiner.JoinEntries(mj, []interface{}{nil}, []int{1})
+	EqualError(t, err, "key at index 0 is nil")
+
+	Equal(t, "a=null", fst(joiner.JoinMap(mj.UseForNil("null"), map[string]*int{"a": nil})))
+	Equal(t, "null=1", fst(joiner.JoinEntries(fst(joiner.On(",").UseForNil("null").WithKeyValueSeparator("=")),
+		[]interface{}{nil}, []int{1})))
+
+	_, err = joiner.On(",").SkipNils().WithKeyValueSeparator("=")
+	EqualError(t, err, "cannot use SkipNils() with maps")
+}
+
+func TestMapJoiner_AppendEntriesTo(t *testing.T) {
+	sb := &strings.Builder{}
+	sb.WriteString("?")
+	NoError(t, joiner.AppendEntriesTo(mj, sb, []string{"q", "page"}, []int{1, 2}))
+	Equal(t, "?q=1&page=2", sb.String())
+}
@@ -0,0 +1,253 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+var (
+	_ json.Marshaler           = Optional[int]{}
+	_ json.Unmarshaler         = (*Optional[int])(nil)
+	_ encoding.TextMarshaler   = Optional[int]{}
+	_ encoding.TextUnmarshaler = (*Optional[int])(nil)
+	_ driver.Valuer            = Optional[int]{}
+	_ sql.Scanner              = (*Optional[int])(nil)
+)
+
+var null = []byte("null")
+
+// IsZero returns true if this holder does not contain an instance.
+//
+// Note that the "omitempty" option of encoding/json never omits struct types, so it cannot omit an absent Optional.
+// To omit absent values from JSON objects, use a pointer to an Optional along with "omitempty", where nil represents
+// an absent value. When built with Go 1.24 or later, the "omitzero" option omits absent values by means of IsZero.
+func (o Optional[T]) IsZero() bool {
+	return !o.present
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// An absent instance is encoded as null, whereas a present instance is encoded like the contained value.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.present {
+		return null, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A JSON null is decoded as absent, whereas any other value is decoded into T.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), null) {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// An absent instance is encoded as empty text. A present instance is encoded by the contained value's MarshalText
+// method, if it implements encoding.TextMarshaler, or else in its default format if it is a string, boolean or number.
+// Since empty text is decoded as absent, an error is returned if a present instance would be encoded as empty text,
+// e.g., Some("").
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.present {
+		return []byte{}, nil
+	}
+
+	text, err := o.marshalValueText()
+	if err != nil {
+		return nil, err
+	}
+	if err := precond.CheckArgumentf(len(text) > 0, "cannot marshal %#v as text: indistinguishable from absent",
+		o.value); err != nil {
+		return nil, err
+	}
+	return text, nil
+}
+
+// marshalValueText encodes the contained value as text.
+func (o Optional[T]) marshalValueText() ([]byte, error) {
+	if m, ok := any(o.value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	rv := reflect.ValueOf(o.value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return []byte(fmt.Sprint(o.value)), nil
+	default:
+		return nil, precond.CheckArgumentf(false, "cannot marshal %T as text", o.value)
+	}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// Empty text is decoded as absent. Otherwise, the text is decoded by the UnmarshalText method of *T, if it implements
+// encoding.TextUnmarshaler, or else parsed according to the kind of T, which must be a string, boolean or number.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if err := parse(reflect.ValueOf(&v).Elem(), string(text)); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+//
+// An absent instance is stored as NULL. A present instance is converted by the contained value's Value method, if it
+// implements driver.Valuer, or else by driver.DefaultParameterConverter.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.present {
+		return nil, nil
+	}
+	if v, ok := any(o.value).(driver.Valuer); ok {
+		return v.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// NULL is scanned as absent. Any other value is scanned by the Scan method of *T, if it implements sql.Scanner, or
+// else assigned to T, converting between the types returned by database drivers and T where necessary.
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+	} else if err := assign(reflect.ValueOf(&v).Elem(), src); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// assign stores src, which is a value returned by a database driver, in dst.
+func assign(dst reflect.Value, src any) error {
+	if dst.Kind() == reflect.Interface {
+		if b, ok := src.([]byte); ok {
+			src = bytes.Clone(b)
+		}
+	}
+
+	switch s := src.(type) {
+	case string:
+		if dst.Kind() != reflect.String && dst.Kind() != reflect.Interface {
+			return parse(dst, s)
+		}
+	case []byte:
+		switch {
+		case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+			dst.SetBytes(bytes.Clone(s))
+			return nil
+		case dst.Kind() == reflect.String:
+			dst.SetString(string(s))
+			return nil
+		case dst.Kind() != reflect.String && dst.Kind() != reflect.Interface:
+			return parse(dst, string(s))
+		}
+	}
+
+	sv := reflect.ValueOf(src)
+	if err := precond.CheckArgumentf(compatible(sv.Kind(), dst.Kind()) && sv.Type().ConvertibleTo(dst.Type()),
+		"cannot scan %T into %s", src, dst.Type()); err != nil {
+		return err
+	}
+	dst.Set(sv.Convert(dst.Type()))
+	return nil
+}
+
+// compatible returns true if a value of kind src can be converted into a value of kind dst without reinterpreting it,
+// e.g., as opposed to converting an integer to a string.
+func compatible(src, dst reflect.Kind) bool {
+	switch src {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch dst {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+		return false
+	default:
+		return src == dst || dst == reflect.Interface
+	}
+}
+
+// parse parses s according to the kind of dst and stores the result in dst.
+func parse(dst reflect.Value, s string) error {
+	var err error
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, dst.Type().Bits())
+		dst.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, dst.Type().Bits())
+		dst.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, dst.Type().Bits())
+		dst.SetFloat(f)
+	default:
+		return precond.CheckArgumentf(false, "cannot parse %q into %s", s, dst.Type())
+	}
+	return err
+}
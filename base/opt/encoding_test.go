@@ -0,0 +1,174 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/base/opt"
+	. "github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City opt.Optional[string] `json:"city"`
+	Zip  opt.Optional[int]    `json:"zip"`
+}
+
+type user struct {
+	Name    string                 `json:"name"`
+	Email   *opt.Optional[string]  `json:"email,omitempty"`
+	Address opt.Optional[address]  `json:"address"`
+	Tags    opt.Optional[[]string] `json:"tags"`
+}
+
+func TestOptional_JSON(t *testing.T) {
+	u := user{
+		Name:    "jane",
+		Address: opt.Some(address{City: opt.Some("Vienna")}),
+		Tags:    opt.Some([]string{"a"}),
+	}
+	data, err := json.Marshal(u)
+	NoError(t, err)
+	Equal(t, `{"name":"jane","address":{"city":"Vienna","zip":null},"tags":["a"]}`, string(data))
+
+	var got user
+	NoError(t, json.Unmarshal(data, &got))
+	Equal(t, u, got)
+
+	data, err = json.Marshal(user{Name: "john"})
+	NoError(t, err)
+	Equal(t, `{"name":"john","address":null,"tags":null}`, string(data))
+
+	got = user{Address: opt.Some(address{})}
+	NoError(t, json.Unmarshal([]byte(`{"name":"john","email":"j@x.org","address":null}`), &got))
+	Equal(t, user{Name: "john", Email: ptr(opt.Some("j@x.org"))}, got)
+}
+
+func TestOptional_JSON_Invalid(t *testing.T) {
+	var o opt.Optional[int]
+	Error(t, json.Unmarshal([]byte(`"a"`), &o))
+	False(t, o.IsPresent())
+}
+
+func TestOptional_JSON_OmitEmpty(t *testing.T) {
+	type probe struct {
+		P *opt.Optional[int] `json:"p,omitempty"`
+		V opt.Optional[int]  `json:"v,omitempty"`
+	}
+	Equal(t, `{"v":null}`, string(fst(json.Marshal(probe{}))))
+	Equal(t, `{"p":1,"v":2}`, string(fst(json.Marshal(probe{ptr(opt.Some(1)), opt.Some(2)}))))
+
+	var got probe
+	NoError(t, json.Unmarshal([]byte(`{}`), &got))
+	Equal(t, probe{}, got)
+}
+
+func TestOptional_IsZero(t *testing.T) {
+	True(t, opt.None[int]().IsZero())
+	True(t, opt.Optional[string]{}.IsZero())
+	False(t, opt.Some(0).IsZero())
+	False(t, opt.Some("").IsZero())
+}
+
+func TestOptional_Text(t *testing.T) {
+	Equal(t, "", string(fst(opt.None[int]().MarshalText())))
+	Equal(t, "42", string(fst(opt.Some(42).MarshalText())))
+	Equal(t, "1.5", string(fst(opt.Some(1.5).MarshalText())))
+	Equal(t, "::1", string(fst(opt.Some(net.IPv6loopback).MarshalText())))
+	_, err := opt.Some([]int{1}).MarshalText()
+	EqualError(t, err, "cannot marshal []int as text")
+
+	var i opt.Optional[int]
+	NoError(t, i.UnmarshalText([]byte("42")))
+	Equal(t, opt.Some(42), i)
+	NoError(t, i.UnmarshalText(nil))
+	Equal(t, opt.None[int](), i)
+	Error(t, i.UnmarshalText([]byte("x")))
+
+	var ip opt.Optional[net.IP]
+	NoError(t, ip.UnmarshalText([]byte("127.0.0.1")))
+	True(t, ip.OrNil().IsLoopback())
+
+	m := map[opt.Optional[int]]string{opt.Some(1): "a"}
+	data, err := json.Marshal(m)
+	NoError(t, err)
+	Equal(t, `{"1":"a"}`, string(data))
+}
+
+func TestOptional_Text_RoundTrip(t *testing.T) {
+	for _, o := range []opt.Optional[string]{opt.None[string](), opt.Some("a"), opt.Some(" "), opt.Some("null")} {
+		text, err := o.MarshalText()
+		NoError(t, err)
+		var got opt.Optional[string]
+		NoError(t, got.UnmarshalText(text))
+		Equal(t, o, got)
+	}
+
+	_, err := opt.Some("").MarshalText()
+	EqualError(t, err, `cannot marshal "" as text: indistinguishable from absent`)
+	_, err = opt.Some(net.IP{}).MarshalText()
+	Error(t, err)
+	_, err = json.Marshal(map[opt.Optional[string]]int{opt.Some(""): 1})
+	Error(t, err)
+}
+
+func TestOptional_Value(t *testing.T) {
+	Nil(t, fst(opt.None[int]().Value()))
+	Equal(t, driver.Value(int64(42)), fst(opt.Some(42).Value()))
+	Equal(t, driver.Value("a"), fst(opt.Some("a").Value()))
+	Equal(t, driver.Value(int64(7)), fst(opt.Some(opt.Some(7)).Value()))
+}
+
+func TestOptional_Scan(t *testing.T) {
+	var i opt.Optional[int32]
+	NoError(t, i.Scan(int64(42)))
+	Equal(t, opt.Some(int32(42)), i)
+	NoError(t, i.Scan([]byte("7")))
+	Equal(t, opt.Some(int32(7)), i)
+	NoError(t, i.Scan(nil))
+	Equal(t, opt.None[int32](), i)
+
+	var s opt.Optional[string]
+	NoError(t, s.Scan([]byte("abc")))
+	Equal(t, opt.Some("abc"), s)
+	EqualError(t, s.Scan(int64(65)), "cannot scan int64 into string")
+
+	var b opt.Optional[[]byte]
+	src := []byte("abc")
+	NoError(t, b.Scan(src))
+	src[0] = 'x'
+	Equal(t, []byte("abc"), b.OrNil())
+
+	var ts opt.Optional[time.Time]
+	now := time.Now()
+	NoError(t, ts.Scan(now))
+	Equal(t, opt.Some(now), ts)
+
+	var a opt.Optional[interface{}]
+	NoError(t, a.Scan("x"))
+	Equal(t, "x", a.OrNil())
+
+	var n opt.Optional[opt.Optional[int]]
+	NoError(t, n.Scan("5"))
+	Equal(t, opt.Some(opt.Some(5)), n)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abc-inc/goava/base/opt"
+)
+
+func Example() {
+	users := map[int]string{1: "jane"}
+	lookup := func(id int) opt.Optional[string] {
+		name, ok := users[id]
+		if !ok {
+			return opt.None[string]()
+		}
+		return opt.Some(name)
+	}
+
+	for _, id := range []int{1, 2} {
+		name := opt.Map(lookup(id), strings.ToUpper)
+		fmt.Println(name.OrElse("<unknown>"))
+	}
+	// Output:
+	// JANE
+	// <unknown>
+}
@@ -16,87 +16,242 @@
 package opt
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/abc-inc/goava/base/precond"
 	"github.com/abc-inc/goava/collect/set"
+	"github.com/abc-inc/goava/internal/reflectutil"
 )
 
-// Optional is an immutable data type that may contain a non-nil value of any type.
+// Optional is an immutable data type that may contain a non-nil value of type T.
 // Each instance of this type either contains a non-nil value, or contains nothing (in which case we say that the
 // value is "absent"); it is never said to "contain nil".
 //
-// A non-nil Optional instance can be used as a replacement for a nillable instance.
+// An Optional can be used as a replacement for a nillable instance.
 // It allows you to represent "a value that must be present" and "a value that might be absent" as two distinct
 // types in your program, which can aid clarity.
 //
+// The zero value of an Optional is absent.
+//
 // # Some uses of this type include
 //
 // • As a method return type, as an alternative to returning nil to indicate that no value was available
 //
 // • To distinguish between "unknown" (for example, not present in a map) and "known to have no value" (present in the
-// map, with value Absent())
+// map, with value None())
 //
 // A common alternative to using this type is to find or create a suitable zero value for the type in question.
 //
 // This type is not intended as a direct analogue of any existing "option" or "maybe" construct from other programming
 // environments, though it may bear some similarities.
-type Optional interface {
-	// IsPresent returns true if this holder contains a (non-nil) instance.
-	IsPresent() bool
+type Optional[T any] struct {
+	value   T
+	present bool
+}
 
-	// Get returns the contained instance, which must be present.
-	//
-	// If the instance might be absent, use Or(defValue) or OrNil() instead.
-	Get() (interface{}, error)
+// None returns an Optional instance with no contained value.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
 
-	// Or returns the contained instance if it is present; defValue otherwise.
-	//
-	// If no default value should be required because the instance is known to be present, use Get() instead.
-	// For a default value of nil, use OrNil().
-	Or(defValue interface{}) (interface{}, error)
+// Some returns an Optional instance containing the given value, if it is non-nil.
+// Otherwise, i.e., if value is a nil pointer, map, slice, channel, function or interface, it returns an absent
+// instance.
+func Some[T any](value T) Optional[T] {
+	if reflectutil.IsNil(value) {
+		return Optional[T]{}
+	}
+	return Optional[T]{value, true}
+}
 
-	// OrOpt returns this Optional if it has a value present; other otherwise.
-	OrOpt(other Optional) (Optional, error)
+// Absent returns an untyped Optional instance with no contained value.
+//
+// It is retained for compatibility; use None instead.
+func Absent() Optional[interface{}] {
+	return None[interface{}]()
+}
 
-	// OrGet returns the contained instance if it is present; the result of the provided function otherwise.
-	OrGet(func() interface{}) (interface{}, error)
+// Of returns an untyped Optional instance containing the given non-nil value.
+//
+// To have nil treated as absent, use FromNillable() instead.
+// It is retained for compatibility; use Some instead.
+func Of(value interface{}) (Optional[interface{}], error) {
+	if _, err := precond.CheckNotNilf(value, "use Optional.FromNillable() instead of Optional.Of(nil)"); err != nil {
+		return Optional[interface{}]{}, err
+	}
+	return Optional[interface{}]{value, true}, nil
+}
 
-	// OrNil returns the contained instance if it is present; nil otherwise.
-	//
-	// If the instance is known to be present, use Get() instead.
-	OrNil() interface{}
+// FromNillable returns an untyped Optional instance containing that value, if it is non-nil.
+// Otherwise, it returns an absent instance.
+//
+// It is retained for compatibility; use Some instead.
+func FromNillable(value interface{}) Optional[interface{}] {
+	if value == nil {
+		return Absent()
+	}
+	return Optional[interface{}]{value, true}
+}
 
-	// AsSet returns a Set whose only element is the contained instance if it is present; an empty Set otherwise.
-	AsSet() set.Set
+// IsPresent returns true if this holder contains a (non-nil) instance.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
 
-	// Transform applies the given function, if the instance is present; otherwise, absent is returned.
-	Transform(func(interface{}) interface{}) (Optional, error)
+// Get returns the contained instance, which must be present.
+//
+// If the instance might be absent, use OrElse(defValue) or OrNil() instead.
+func (o Optional[T]) Get() (T, error) {
+	if err := precond.CheckStatef(o.present, "Optional.Get() cannot be called on an absent value"); err != nil {
+		return o.value, err
+	}
+	return o.value, nil
+}
 
-	// String returns a string representation for this instance.
-	String() string
+// Or returns the contained instance if it is present; defValue otherwise.
+//
+// An error is returned if defValue is nil. Use OrElse instead, which accepts any default value.
+func (o Optional[T]) Or(defValue T) (T, error) {
+	if _, err := precond.CheckNotNilf(defValue, "use Optional.OrNil() instead of Optional.Or(nil)"); err != nil {
+		return o.value, err
+	}
+	return o.OrElse(defValue), nil
 }
 
-var absentInstance = absent{}
+// OrElse returns the contained instance if it is present; defValue otherwise.
+func (o Optional[T]) OrElse(defValue T) T {
+	if !o.present {
+		return defValue
+	}
+	return o.value
+}
 
-// Absent returns an Optional instance with no contained value.
-func Absent() Optional {
-	return absentInstance
+// OrOpt returns this Optional if it has a value present; other otherwise.
+func (o Optional[T]) OrOpt(other Optional[T]) Optional[T] {
+	if !o.present {
+		return other
+	}
+	return o
 }
 
-// Of returns an Optional instance containing the given non-nil value.
+// OrOptGet returns this Optional if it has a value present; the result of the provided function otherwise.
 //
-// To have nil treated as absent, use FromNillable() instead.
-func Of(value interface{}) (Optional, error) {
-	if _, err := precond.CheckNotNilf(value, "use Optional.FromNillable() instead of Optional.Of(nil)"); err != nil {
-		return nil, err
+// The function is only invoked if the instance is absent, which allows chaining lazily evaluated alternatives.
+func (o Optional[T]) OrOptGet(supplier func() Optional[T]) Optional[T] {
+	if !o.present {
+		return supplier()
 	}
-	return present{value}, nil
+	return o
 }
 
-// FromNillable returns an Optional instance containing that value, if it is non-nil.
-// Otherwise, it returns an absent instance.
-func FromNillable(value interface{}) Optional {
-	if value == nil {
-		return Absent()
+// OrGet returns the contained instance if it is present; the result of the provided function otherwise.
+//
+// An error is returned if the function is nil or if it returns nil. Use OrElseGet instead, which accepts any result.
+func (o Optional[T]) OrGet(supplier func() T) (T, error) {
+	if supplier == nil {
+		return o.value, errors.New("the function passed to Optional.OrGet() must not be nil")
+	}
+	if o.present {
+		return o.value, nil
+	}
+
+	v := supplier()
+	if _, err := precond.CheckNotNilf(v, "use Optional.OrNil() instead of a function that returns nil"); err != nil {
+		return o.value, err
+	}
+	return v, nil
+}
+
+// OrElseGet returns the contained instance if it is present; the result of the provided function otherwise.
+//
+// The function is only invoked if the instance is absent.
+func (o Optional[T]) OrElseGet(supplier func() T) T {
+	if !o.present {
+		return supplier()
+	}
+	return o.value
+}
+
+// OrNil returns the contained instance if it is present; the zero value of T (i.e., nil for nillable types) otherwise.
+//
+// If the instance is known to be present, use Get() instead.
+func (o Optional[T]) OrNil() T {
+	return o.value
+}
+
+// Filter returns this Optional if it has a value present, which satisfies the given predicate; absent otherwise.
+func (o Optional[T]) Filter(pred func(T) bool) Optional[T] {
+	if !o.present || !pred(o.value) {
+		return Optional[T]{}
+	}
+	return o
+}
+
+// IfPresent invokes f with the contained instance if it is present; otherwise, it does nothing.
+func (o Optional[T]) IfPresent(f func(T)) {
+	if o.present {
+		f(o.value)
+	}
+}
+
+// ToSlice returns a slice whose only element is the contained instance if it is present; an empty slice otherwise.
+func (o Optional[T]) ToSlice() []T {
+	if !o.present {
+		return []T{}
+	}
+	return []T{o.value}
+}
+
+// AsSet returns a Set whose only element is the contained instance if it is present; an empty Set otherwise.
+func (o Optional[T]) AsSet() set.Set {
+	if !o.present {
+		return set.Empty()
+	}
+	return set.Singleton(o.value)
+}
+
+// Transform applies the given function, if the instance is present; otherwise, absent is returned.
+//
+// An error is returned if the function is nil or if it returns nil. Use Map instead, which maps nil results to absent
+// and supports a different result type.
+func (o Optional[T]) Transform(f func(T) T) (Optional[T], error) {
+	if f == nil {
+		return Optional[T]{}, errors.New("the function passed to Optional.Transform() must not be nil")
+	}
+	if !o.present {
+		return o, nil
+	}
+
+	v := f(o.value)
+	if _, err := precond.CheckNotNilf(v, "the function passed to Optional.Transform() must not return nil"); err != nil {
+		return Optional[T]{}, err
+	}
+	return Optional[T]{v, true}, nil
+}
+
+// String returns a string representation for this instance.
+func (o Optional[T]) String() string {
+	if !o.present {
+		return "Optional.Absent()"
+	}
+	return fmt.Sprintf("Optional.of(%v)", o.value)
+}
+
+// Map applies the given function to the contained instance of o, if it is present, and returns an Optional containing
+// the result, unless it is nil; otherwise, absent is returned.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.present {
+		return Optional[U]{}
+	}
+	return Some(f(o.value))
+}
+
+// FlatMap applies the given function to the contained instance of o, if it is present, and returns its result;
+// otherwise, absent is returned.
+func FlatMap[T, U any](o Optional[T], f func(T) Optional[U]) Optional[U] {
+	if !o.present {
+		return Optional[U]{}
 	}
-	return present{value}
+	return f(o.value)
 }
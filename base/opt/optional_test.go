@@ -16,6 +16,8 @@ package opt_test
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/abc-inc/goava/base/opt"
@@ -140,7 +142,7 @@ func TestAbsent_OrOpt(t *testing.T) {
 	want, _ := opt.Of("fallback")
 	a := opt.Absent()
 	f, _ := opt.Of("fallback")
-	o, _ := a.OrOpt(f)
+	o := a.OrOpt(f)
 	Equal(t, want, o)
 }
 
@@ -148,21 +150,10 @@ func TestPresent_OrOpt(t *testing.T) {
 	want, _ := opt.Of("a")
 	a, _ := opt.Of("a")
 	f, _ := opt.Of("fallback")
-	o, _ := a.OrOpt(f)
+	o := a.OrOpt(f)
 	Equal(t, want, o)
 }
 
-func TestAbsent_OrOpt_Nil(t *testing.T) {
-	_, err := opt.Absent().OrOpt(nil)
-	EqualError(t, err, "use Optional.OrNil() instead of a nil Optional")
-}
-
-func TestPresent_OrOpt_Nil(t *testing.T) {
-	a, _ := opt.Of("a")
-	_, err := a.OrOpt(nil)
-	EqualError(t, err, "use Optional.OrNil() instead of a nil Optional")
-}
-
 func TestAbsent_OrNil(t *testing.T) {
 	Nil(t, opt.Absent().OrNil())
 }
@@ -234,3 +225,94 @@ func TestPresent_String(t *testing.T) {
 	o, _ := opt.Of("training")
 	Equal(t, "Optional.of(training)", o.String())
 }
+
+func TestNone(t *testing.T) {
+	o := opt.None[int]()
+	False(t, o.IsPresent())
+	Equal(t, opt.Optional[int]{}, o)
+	_, err := o.Get()
+	EqualError(t, err, "Optional.Get() cannot be called on an absent value")
+}
+
+func TestSome(t *testing.T) {
+	o := opt.Some(0)
+	True(t, o.IsPresent())
+	Equal(t, 0, fst(o.Get()))
+
+	var p *int
+	False(t, opt.Some(p).IsPresent())
+	False(t, opt.Some[[]int](nil).IsPresent())
+	False(t, opt.Some[error](nil).IsPresent())
+	True(t, opt.Some([]int{}).IsPresent())
+}
+
+func TestOptional_OrElse(t *testing.T) {
+	Equal(t, 1, opt.Some(1).OrElse(2))
+	Equal(t, 2, opt.None[int]().OrElse(2))
+}
+
+func TestOptional_OrElseGet(t *testing.T) {
+	Equal(t, 1, opt.Some(1).OrElseGet(func() int { panic("don't call me") }))
+	Equal(t, 2, opt.None[int]().OrElseGet(func() int { return 2 }))
+}
+
+func TestOptional_OrNil(t *testing.T) {
+	Nil(t, opt.None[*int]().OrNil())
+	Equal(t, "", opt.None[string]().OrNil())
+}
+
+func TestOptional_Filter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	Equal(t, opt.Some(2), opt.Some(2).Filter(even))
+	Equal(t, opt.None[int](), opt.Some(1).Filter(even))
+	Equal(t, opt.None[int](), opt.None[int]().Filter(even))
+}
+
+func TestOptional_IfPresent(t *testing.T) {
+	var vs []string
+	opt.Some("a").IfPresent(func(s string) { vs = append(vs, s) })
+	opt.None[string]().IfPresent(func(s string) { vs = append(vs, s) })
+	Equal(t, []string{"a"}, vs)
+}
+
+func TestOptional_ToSlice(t *testing.T) {
+	Equal(t, []string{"a"}, opt.Some("a").ToSlice())
+	Equal(t, []string{}, opt.None[string]().ToSlice())
+}
+
+func TestOptional_Transform(t *testing.T) {
+	o, err := opt.Some("a").Transform(strings.ToUpper)
+	NoError(t, err)
+	Equal(t, opt.Some("A"), o)
+}
+
+func TestMap(t *testing.T) {
+	Equal(t, opt.Some(3), opt.Map(opt.Some("abc"), func(s string) int { return len(s) }))
+	Equal(t, opt.None[int](), opt.Map(opt.None[string](), func(s string) int { panic("don't call me") }))
+	Equal(t, opt.None[*int](), opt.Map(opt.Some("abc"), func(s string) *int { return nil }))
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) opt.Optional[int] {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return opt.None[int]()
+		}
+		return opt.Some(i)
+	}
+	Equal(t, opt.Some(42), opt.FlatMap(opt.Some("42"), parse))
+	Equal(t, opt.None[int](), opt.FlatMap(opt.Some("x"), parse))
+	Equal(t, opt.None[int](), opt.FlatMap(opt.None[string](), parse))
+}
+
+func TestOptional_String(t *testing.T) {
+	Equal(t, "Optional.Absent()", opt.None[int]().String())
+	Equal(t, "Optional.of(42)", opt.Some(42).String())
+}
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
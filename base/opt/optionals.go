@@ -0,0 +1,61 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt
+
+// PresentInstances returns the value of each present instance from the given optionals, in order, skipping over
+// occurrences of absent.
+func PresentInstances[T any](opts []Optional[T]) []T {
+	vs := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.present {
+			vs = append(vs, o.value)
+		}
+	}
+	return vs
+}
+
+// FirstPresent invokes the given suppliers in order until one of them returns a present instance, and returns that
+// instance; the remaining suppliers are not invoked.
+// If no supplier returns a present instance, absent is returned.
+func FirstPresent[T any](suppliers ...func() Optional[T]) Optional[T] {
+	for _, s := range suppliers {
+		if o := s(); o.present {
+			return o
+		}
+	}
+	return Optional[T]{}
+}
+
+// Zip applies the given function to the contained instances of a and b, if both are present, and returns an Optional
+// containing the result, unless it is nil; otherwise, absent is returned.
+func Zip[A, B, R any](a Optional[A], b Optional[B], f func(A, B) R) Optional[R] {
+	if !a.present || !b.present {
+		return Optional[R]{}
+	}
+	return Some(f(a.value, b.value))
+}
+
+// Combine returns an Optional containing the values of all given optionals, in order, if all of them are present;
+// otherwise, absent is returned.
+func Combine[T any](opts ...Optional[T]) Optional[[]T] {
+	vs := make([]T, len(opts))
+	for i, o := range opts {
+		if !o.present {
+			return Optional[[]T]{}
+		}
+		vs[i] = o.value
+	}
+	return Some(vs)
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/abc-inc/goava/base/opt"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestPresentInstances(t *testing.T) {
+	Equal(t, []int{1, 3}, opt.PresentInstances([]opt.Optional[int]{opt.Some(1), opt.None[int](), opt.Some(3)}))
+	Equal(t, []int{}, opt.PresentInstances([]opt.Optional[int]{opt.None[int]()}))
+	Equal(t, []int{}, opt.PresentInstances[int](nil))
+}
+
+func TestFirstPresent(t *testing.T) {
+	var calls []string
+	supplier := func(name string, o opt.Optional[string]) func() opt.Optional[string] {
+		return func() opt.Optional[string] {
+			calls = append(calls, name)
+			return o
+		}
+	}
+
+	o := opt.FirstPresent(
+		supplier("a", opt.None[string]()),
+		supplier("b", opt.Some("b")),
+		supplier("c", opt.Some("c")))
+	Equal(t, opt.Some("b"), o)
+	Equal(t, []string{"a", "b"}, calls)
+
+	Equal(t, opt.None[string](), opt.FirstPresent(supplier("d", opt.None[string]())))
+	Equal(t, opt.None[string](), opt.FirstPresent[string]())
+}
+
+func TestOptional_OrOptGet(t *testing.T) {
+	dontCallMe := func() opt.Optional[int] { panic("don't call me") }
+	Equal(t, opt.Some(1), opt.Some(1).OrOptGet(dontCallMe))
+	Equal(t, opt.Some(2), opt.None[int]().OrOptGet(func() opt.Optional[int] { return opt.Some(2) }))
+	Equal(t, opt.None[int](), opt.None[int]().OrOptGet(opt.None[int]))
+}
+
+func TestZip(t *testing.T) {
+	rep := func(s string, n int) string {
+		r := ""
+		for i := 0; i < n; i++ {
+			r += s
+		}
+		return r
+	}
+	Equal(t, opt.Some("abab"), opt.Zip(opt.Some("ab"), opt.Some(2), rep))
+	Equal(t, opt.None[string](), opt.Zip(opt.None[string](), opt.Some(2), rep))
+	Equal(t, opt.None[string](), opt.Zip(opt.Some("ab"), opt.None[int](), rep))
+	Equal(t, opt.None[*int](), opt.Zip(opt.Some(1), opt.Some(2), func(int, int) *int { return nil }))
+}
+
+func TestCombine(t *testing.T) {
+	Equal(t, opt.Some([]int{1, 2}), opt.Combine(opt.Some(1), opt.Some(2)))
+	Equal(t, opt.None[[]int](), opt.Combine(opt.Some(1), opt.None[int]()))
+	Equal(t, opt.Some([]int{}), opt.Combine[int]())
+
+	parsed := opt.Map(opt.Combine(opt.Some("1"), opt.Some("2")), func(ss []string) int {
+		sum := 0
+		for _, s := range ss {
+			i, _ := strconv.Atoi(s)
+			sum += i
+		}
+		return sum
+	})
+	Equal(t, opt.Some(3), parsed)
+}
@@ -20,51 +20,134 @@
 package precond
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
-	"strconv"
+
+	"github.com/abc-inc/goava/internal/errsite"
+	"github.com/abc-inc/goava/internal/reflectutil"
+)
+
+// Sentinel errors, which identify the kind of a failed precondition by means of errors.Is.
+var (
+	// ErrIllegalArgument is matched by every *IllegalArgumentError.
+	ErrIllegalArgument = errors.New("illegal argument")
+	// ErrIllegalState is matched by every *IllegalStateError.
+	ErrIllegalState = errors.New("illegal state")
+	// ErrIndexOutOfBounds is matched by every *IndexOutOfBoundsError.
+	ErrIndexOutOfBounds = errors.New("index out of bounds")
+	// ErrNil is matched by every *NilError.
+	ErrNil = errors.New("nil")
 )
 
+// integer is a constraint that permits any integer type.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// failure holds the details common to all errors returned by failed preconditions.
+type failure struct {
+	msg   string
+	cause error
+	site  errsite.Site
+}
+
+func newFailure(msg string, cause error) failure {
+	return failure{msg, cause, errsite.Capture()}
+}
+
+// Caller returns the location ("file:line") of the outermost call into Goava, which returned the error.
+// If a check fails within Goava, this is the call site in the calling code rather than the check itself.
+func (f failure) Caller() string {
+	return f.site.String()
+}
+
+// Unwrap returns the cause of the error, if any.
+func (f failure) Unwrap() error {
+	return f.cause
+}
+
 // IllegalArgumentError indicates that a function has been passed an illegal or inappropriate argument.
 type IllegalArgumentError struct {
-	msg string
+	failure
 }
 
 func (e *IllegalArgumentError) Error() string {
 	return e.msg
 }
 
+// Is reports whether target is ErrIllegalArgument.
+func (e *IllegalArgumentError) Is(target error) bool {
+	return target == ErrIllegalArgument
+}
+
+// Format formats the error according to the fmt.Formatter interface.
+// The verb %+v additionally prints the location of the failed check and the cause, if any.
+func (e *IllegalArgumentError) Format(s fmt.State, verb rune) {
+	format(s, verb, e, e.failure)
+}
+
 // IllegalStateError signals that a function has been invoked at an illegal or inappropriate time.
 type IllegalStateError struct {
-	msg string
+	failure
 }
 
 func (e *IllegalStateError) Error() string {
 	return e.msg
 }
 
+// Is reports whether target is ErrIllegalState.
+func (e *IllegalStateError) Is(target error) bool {
+	return target == ErrIllegalState
+}
+
+// Format formats the error according to the fmt.Formatter interface.
+// The verb %+v additionally prints the location of the failed check and the cause, if any.
+func (e *IllegalStateError) Format(s fmt.State, verb rune) {
+	format(s, verb, e, e.failure)
+}
+
 // IndexOutOfBoundsError indicates that an index of some sort (such as to an array or to a string) is out of range.
 type IndexOutOfBoundsError struct {
-	index  int
-	size   int
-	desc   string
-	args   []interface{}
-	msgFun func(int, int, string, ...interface{}) string
+	failure
+	msgFun func() string
 }
 
 func (e *IndexOutOfBoundsError) Error() string {
-	return e.msgFun(e.index, e.size, e.desc, e.args...)
+	return e.msgFun()
+}
+
+// Is reports whether target is ErrIndexOutOfBounds.
+func (e *IndexOutOfBoundsError) Is(target error) bool {
+	return target == ErrIndexOutOfBounds
+}
+
+// Format formats the error according to the fmt.Formatter interface.
+// The verb %+v additionally prints the location of the failed check and the cause, if any.
+func (e *IndexOutOfBoundsError) Format(s fmt.State, verb rune) {
+	format(s, verb, e, e.failure)
 }
 
 // NilError indicates when an application attempts to use nil in a case where an object is required.
 type NilError struct {
-	msg string
+	failure
 }
 
 func (e *NilError) Error() string {
 	return e.msg
 }
 
+// Is reports whether target is ErrNil.
+func (e *NilError) Is(target error) bool {
+	return target == ErrNil
+}
+
+// Format formats the error according to the fmt.Formatter interface.
+// The verb %+v additionally prints the location of the failed check and the cause, if any.
+func (e *NilError) Format(s fmt.State, verb rune) {
+	format(s, verb, e, e.failure)
+}
+
 // CheckArgument ensures the truth of an expression involving one or more parameters to the calling method.
 func CheckArgument(expr bool) error {
 	return CheckArgumentf(expr, "invalid argument")
@@ -72,8 +155,14 @@ func CheckArgument(expr bool) error {
 
 // CheckArgumentf ensures the truth of an expression involving one or more parameters to the calling method.
 func CheckArgumentf(expr bool, desc string, args ...interface{}) error {
+	return CheckArgumentWrapf(expr, nil, desc, args...)
+}
+
+// CheckArgumentWrapf ensures the truth of an expression involving one or more parameters to the calling method.
+// The returned error wraps the given cause, which may be nil.
+func CheckArgumentWrapf(expr bool, cause error, desc string, args ...interface{}) error {
 	if !expr {
-		return &IllegalArgumentError{fmt.Sprintf(desc, args...)}
+		return &IllegalArgumentError{newFailure(fmt.Sprintf(desc, args...), cause)}
 	}
 	return nil
 }
@@ -87,91 +176,160 @@ func CheckState(expr bool) error {
 // CheckStatef ensures the truth of an expression involving the state of the calling instance, but not involving any
 // parameters to the calling method.
 func CheckStatef(expr bool, desc string, args ...interface{}) error {
+	return CheckStateWrapf(expr, nil, desc, args...)
+}
+
+// CheckStateWrapf ensures the truth of an expression involving the state of the calling instance, but not involving
+// any parameters to the calling method.
+// The returned error wraps the given cause, which may be nil.
+func CheckStateWrapf(expr bool, cause error, desc string, args ...interface{}) error {
 	if !expr {
-		return &IllegalStateError{fmt.Sprintf(desc, args...)}
+		return &IllegalStateError{newFailure(fmt.Sprintf(desc, args...), cause)}
 	}
 	return nil
 }
 
 // CheckNotNil ensures that an object passed as a parameter to the calling method is not nil.
-func CheckNotNil(obj interface{}) (interface{}, error) {
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func CheckNotNil[T any](obj T) (T, error) {
 	return CheckNotNilf(obj, "")
 }
 
 // CheckNotNilf ensures that an object passed as a parameter to the calling method is not nil.
-func CheckNotNilf(obj interface{}, desc string, args ...interface{}) (interface{}, error) {
-	if obj == nil {
-		return nil, &NilError{fmt.Sprintf(desc, args...)}
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func CheckNotNilf[T any](obj T, desc string, args ...interface{}) (T, error) {
+	return CheckNotNilWrapf(obj, nil, desc, args...)
+}
+
+// CheckNotNilWrapf ensures that an object passed as a parameter to the calling method is not nil.
+// The returned error wraps the given cause, which may be nil.
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func CheckNotNilWrapf[T any](obj T, cause error, desc string, args ...interface{}) (T, error) {
+	if reflectutil.IsNil(obj) {
+		return obj, &NilError{newFailure(fmt.Sprintf(desc, args...), cause)}
 	}
 	return obj, nil
 }
 
 // CheckElementIndex ensures that index specifies a valid element in an array or string of the given size.
 // An element index may range from zero, inclusive, to size, exclusive.
-func CheckElementIndex(index, size int) (int, error) {
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckElementIndex[T integer](index, size T) (T, error) {
 	return CheckElementIndexf(index, size, "index")
 }
 
 // CheckElementIndexf ensures that index specifies a valid element in an array or string of the given size.
 // An element index may range from zero, inclusive, to size, exclusive.
-func CheckElementIndexf(index, size int, desc string, args ...interface{}) (int, error) {
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckElementIndexf[T integer](index, size T, desc string, args ...interface{}) (T, error) {
+	return CheckElementIndexWrapf(index, size, nil, desc, args...)
+}
+
+// CheckElementIndexWrapf ensures that index specifies a valid element in an array or string of the given size.
+// An element index may range from zero, inclusive, to size, exclusive.
+// The returned error wraps the given cause, which may be nil.
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckElementIndexWrapf[T integer](index, size T, cause error, desc string, args ...interface{}) (T, error) {
 	if index < 0 || index >= size {
-		return -1, &IndexOutOfBoundsError{index, size, desc, args, badElementIndex}
+		msgFun := func() string { return badElementIndex(index, size, desc, args...) }
+		return ^T(0), &IndexOutOfBoundsError{newFailure("", cause), msgFun}
 	}
 	return index, nil
 }
 
 // CheckPositionIndex ensures that index specifies a valid position in an array, list or string of the given size.
 // A position index may range from zero to size, inclusive.
-func CheckPositionIndex(index, size int) (int, error) {
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckPositionIndex[T integer](index, size T) (T, error) {
 	return CheckPositionIndexf(index, size, "index")
 }
 
 // CheckPositionIndexf ensures that index specifies a valid position in an array, list or string of the given size.
 // A position index may range from zero to size, inclusive.
-func CheckPositionIndexf(index, size int, desc string, args ...interface{}) (int, error) {
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckPositionIndexf[T integer](index, size T, desc string, args ...interface{}) (T, error) {
+	return CheckPositionIndexWrapf(index, size, nil, desc, args...)
+}
+
+// CheckPositionIndexWrapf ensures that index specifies a valid position in an array, list or string of the given
+// size. A position index may range from zero to size, inclusive.
+// The returned error wraps the given cause, which may be nil.
+//
+// If the index is invalid, it returns -1 (or the maximum value of an unsigned type T) along with an error.
+func CheckPositionIndexWrapf[T integer](index, size T, cause error, desc string, args ...interface{}) (T, error) {
 	if index < 0 || index > size {
-		return -1, &IndexOutOfBoundsError{index, size, desc, args, badPositionIndex}
+		msgFun := func() string { return badPositionIndex(index, size, desc, args...) }
+		return ^T(0), &IndexOutOfBoundsError{newFailure("", cause), msgFun}
 	}
 	return index, nil
 }
 
-func badElementIndex(index, size int, desc string, args ...interface{}) string {
+func badElementIndex[T integer](index, size T, desc string, args ...interface{}) string {
 	if args != nil {
 		return fmt.Sprintf(desc, args...)
 	} else if index < 0 {
 		return fmt.Sprintf("%s (%d) must not be negative", desc, index)
 	} else if size < 0 {
-		return "negative size: " + strconv.Itoa(size)
+		return fmt.Sprintf("negative size: %d", size)
 	} else { // index >= size
 		return fmt.Sprintf("%s (%d) must be less than size (%d)", desc, index, size)
 	}
 }
 
-func badPositionIndex(index, size int, desc string, args ...interface{}) string {
+func badPositionIndex[T integer](index, size T, desc string, args ...interface{}) string {
 	if args != nil {
 		return fmt.Sprintf(desc, args...)
 	} else if index < 0 {
 		return fmt.Sprintf("%s (%d) must not be negative", desc, index)
 	} else if size < 0 {
-		return fmt.Sprintf("negative size: " + strconv.Itoa(size))
+		return fmt.Sprintf("negative size: %d", size)
 	} else { // index > size
 		return fmt.Sprintf("%s (%d) must not be greater than size (%d)", desc, index, size)
 	}
 }
 
-// CheckNonnegative ensures that value is strictly positive.
-func CheckNonnegative(value int, name string) (int, error) {
-	if value < 0 {
-		return 0, errors.New(name + " cannot be negative but was: " + strconv.Itoa(value))
+// CheckInRange ensures that value is within the closed range [min, max].
+//
+// The error message refers to the value by the given name, e.g., "port (0) must not be less than min (1)".
+func CheckInRange[T cmp.Ordered](value, min, max T, name string) (T, error) {
+	if value < min {
+		return value, &IllegalArgumentError{newFailure(
+			fmt.Sprintf("%s (%v) must not be less than min (%v)", name, value, min), nil)}
+	} else if value > max {
+		return value, &IllegalArgumentError{newFailure(
+			fmt.Sprintf("%s (%v) must not be greater than max (%v)", name, value, max), nil)}
 	}
 	return value, nil
 }
 
-// CheckNonnegative64 ensures that value is strictly positive.
-func CheckNonnegative64(value int64, name string) (int64, error) {
+// CheckNonnegative ensures that value is not negative.
+func CheckNonnegative[T integer](value T, name string) (T, error) {
 	if value < 0 {
-		return 0, errors.New(name + " cannot be negative but was: " + strconv.FormatInt(value, 10))
+		msg := fmt.Sprintf("%s cannot be negative but was: %d", name, value)
+		return 0, &IllegalArgumentError{newFailure(msg, nil)}
 	}
 	return value, nil
 }
+
+// CheckNonnegative64 ensures that value is not negative.
+//
+// It is equivalent to CheckNonnegative[int64].
+func CheckNonnegative64(value int64, name string) (int64, error) {
+	return CheckNonnegative(value, name)
+}
+
+// format formats err, which has the details f, according to the fmt.Formatter interface.
+func format(s fmt.State, verb rune, err error, f failure) {
+	errsite.Format(s, verb, err.Error(), f.site, f.cause)
+}
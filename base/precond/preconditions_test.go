@@ -15,6 +15,8 @@
 package precond_test
 
 import (
+	"fmt"
+	"io"
 	"testing"
 
 	. "github.com/abc-inc/goava/base/precond"
@@ -36,8 +38,8 @@ func TestCheckNotNil(t *testing.T) {
 	NotNil(t, v)
 	NoError(t, err)
 
-	v, err = CheckNotNilf(nil, "%v", nil)
-	Nil(t, v)
+	o, err := CheckNotNilf[interface{}](nil, "%v", nil)
+	Nil(t, o)
 	EqualError(t, err, "<nil>")
 }
 
@@ -112,3 +114,190 @@ func TestCheckNonnegative64(t *testing.T) {
 	_, err = CheckNonnegative64(-1, "a")
 	EqualError(t, err, "a cannot be negative but was: -1")
 }
+
+func TestErrors_Is(t *testing.T) {
+	_, errNil := CheckNotNil[interface{}](nil)
+	_, errIdx := CheckElementIndex(1, 1)
+	_, errNeg := CheckNonnegative(-1, "a")
+
+	ErrorIs(t, CheckArgument(false), ErrIllegalArgument)
+	ErrorIs(t, errNeg, ErrIllegalArgument)
+	ErrorIs(t, CheckState(false), ErrIllegalState)
+	ErrorIs(t, errIdx, ErrIndexOutOfBounds)
+	ErrorIs(t, errNil, ErrNil)
+	NotErrorIs(t, CheckArgument(false), ErrIllegalState)
+	NotErrorIs(t, CheckState(false), ErrIllegalArgument)
+}
+
+func TestErrors_As(t *testing.T) {
+	var iae *IllegalArgumentError
+	ErrorAs(t, fmt.Errorf("wrapped: %w", CheckArgument(false)), &iae)
+	Equal(t, "invalid argument", iae.Error())
+
+	var ise *IllegalStateError
+	ErrorAs(t, CheckState(false), &ise)
+
+	var ioobe *IndexOutOfBoundsError
+	_, err := CheckPositionIndex(2, 1)
+	ErrorAs(t, err, &ioobe)
+
+	var ne *NilError
+	_, err = CheckNotNil[interface{}](nil)
+	ErrorAs(t, err, &ne)
+}
+
+func TestErrors_Caller(t *testing.T) {
+	err := CheckArgument(false)
+	var iae *IllegalArgumentError
+	ErrorAs(t, err, &iae)
+	Regexp(t, `/base/precond/preconditions_test\.go:\d+$`, iae.Caller())
+
+	_, err = CheckElementIndex(-1, 1)
+	var ioobe *IndexOutOfBoundsError
+	ErrorAs(t, err, &ioobe)
+	Regexp(t, `/base/precond/preconditions_test\.go:\d+$`, ioobe.Caller())
+}
+
+func TestCheckArgumentWrapf(t *testing.T) {
+	NoError(t, CheckArgumentWrapf(true, io.EOF, "unused"))
+
+	err := CheckArgumentWrapf(false, io.EOF, "cannot read %s", "header")
+	EqualError(t, err, "cannot read header")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, ErrIllegalArgument)
+	Regexp(t, `^cannot read header\n\tat .*/preconditions_test\.go:\d+\ncaused by: EOF$`, fmt.Sprintf("%+v", err))
+	Equal(t, "cannot read header", fmt.Sprintf("%v", err))
+	Equal(t, `"cannot read header"`, fmt.Sprintf("%q", err))
+}
+
+func TestCheckStateWrapf(t *testing.T) {
+	NoError(t, CheckStateWrapf(true, io.EOF, "unused"))
+
+	err := CheckStateWrapf(false, io.EOF, "closed")
+	EqualError(t, err, "closed")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, ErrIllegalState)
+	Regexp(t, `^closed\n\tat .*/preconditions_test\.go:\d+$`, fmt.Sprintf("%+v", CheckStateWrapf(false, nil, "closed")))
+}
+
+func TestCheckNotNilWrapf(t *testing.T) {
+	v, err := CheckNotNilWrapf("a", io.EOF, "unused")
+	NoError(t, err)
+	Equal(t, "a", v)
+
+	_, err = CheckNotNilWrapf((*int)(nil), io.EOF, "no %s", "header")
+	EqualError(t, err, "no header")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, ErrNil)
+	Regexp(t, `^no header\n\tat .*/preconditions_test\.go:\d+\ncaused by: EOF$`, fmt.Sprintf("%+v", err))
+}
+
+func TestCheckElementIndexWrapf(t *testing.T) {
+	i, err := CheckElementIndexWrapf(0, 1, io.EOF, "unused")
+	NoError(t, err)
+	Equal(t, 0, i)
+
+	i, err = CheckElementIndexWrapf(1, 1, io.EOF, "offset")
+	Equal(t, -1, i)
+	EqualError(t, err, "offset (1) must be less than size (1)")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, ErrIndexOutOfBounds)
+	Regexp(t, `^offset \(1\) must be less than size \(1\)\n\tat .*/preconditions_test\.go:\d+\ncaused by: EOF$`,
+		fmt.Sprintf("%+v", err))
+}
+
+func TestCheckPositionIndexWrapf(t *testing.T) {
+	i, err := CheckPositionIndexWrapf(1, 1, io.EOF, "unused")
+	NoError(t, err)
+	Equal(t, 1, i)
+
+	i, err = CheckPositionIndexWrapf(2, 1, io.EOF, "offset %d", 2)
+	Equal(t, -1, i)
+	EqualError(t, err, "offset 2")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestCheckNotNil_TypedNil(t *testing.T) {
+	var p *int
+	var m map[string]int
+	var s []int
+	var f func()
+	var e error
+	var i interface{} = p
+
+	for _, v := range []interface{}{p, m, s, f, i} {
+		_, err := CheckNotNilf(v, "%T", v)
+		ErrorIs(t, err, ErrNil)
+	}
+	_, err := CheckNotNil(p)
+	ErrorIs(t, err, ErrNil)
+	_, err = CheckNotNil(e)
+	ErrorIs(t, err, ErrNil)
+
+	n := 1
+	v, err := CheckNotNil(&n)
+	NoError(t, err)
+	Equal(t, &n, v)
+
+	z, err := CheckNotNil(0)
+	NoError(t, err)
+	Equal(t, 0, z)
+}
+
+func TestCheckElementIndex_Generic(t *testing.T) {
+	i, err := CheckElementIndex(uint8(2), uint8(3))
+	NoError(t, err)
+	Equal(t, uint8(2), i)
+
+	u, err := CheckElementIndex(uint(3), uint(3))
+	EqualError(t, err, "index (3) must be less than size (3)")
+	Equal(t, ^uint(0), u)
+
+	i64, err := CheckElementIndexf(int64(-1), int64(3), "offset")
+	EqualError(t, err, "offset (-1) must not be negative")
+	Equal(t, int64(-1), i64)
+
+	_, err = CheckElementIndex(int16(0), int16(-2))
+	EqualError(t, err, "negative size: -2")
+}
+
+func TestCheckPositionIndex_Generic(t *testing.T) {
+	i, err := CheckPositionIndex(uint16(3), uint16(3))
+	NoError(t, err)
+	Equal(t, uint16(3), i)
+
+	_, err = CheckPositionIndexf(uint64(1<<63), uint64(1), "pos")
+	EqualError(t, err, "pos (9223372036854775808) must not be greater than size (1)")
+	ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestCheckInRange(t *testing.T) {
+	v, err := CheckInRange(1, 1, 3, "port")
+	NoError(t, err)
+	Equal(t, 1, v)
+
+	_, err = CheckInRange(0, 1, 3, "port")
+	EqualError(t, err, "port (0) must not be less than min (1)")
+	ErrorIs(t, err, ErrIllegalArgument)
+
+	_, err = CheckInRange(3.5, 1.0, 3.0, "ratio")
+	EqualError(t, err, "ratio (3.5) must not be greater than max (3)")
+
+	s, err := CheckInRange("b", "a", "c", "s")
+	NoError(t, err)
+	Equal(t, "b", s)
+}
+
+func TestCheckNonnegative_Generic(t *testing.T) {
+	v, err := CheckNonnegative(int8(5), "a")
+	NoError(t, err)
+	Equal(t, int8(5), v)
+
+	_, err = CheckNonnegative(int32(-7), "b")
+	EqualError(t, err, "b cannot be negative but was: -7")
+
+	u, err := CheckNonnegative(uint(7), "c")
+	NoError(t, err)
+	Equal(t, uint(7), u)
+}
@@ -0,0 +1,387 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precond
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// CheckFunc is a custom check, which can be referred to in struct tags after registering it via RegisterCheck.
+//
+// It is passed the value of the field and the parameter given in the tag (e.g., "3" for `check:"maxlen=3"`), which is
+// empty if the tag does not specify a parameter. It returns an error if the check fails.
+type CheckFunc func(v reflect.Value, param string) error
+
+var (
+	checkFuncs = map[string]CheckFunc{}
+	checkMu    sync.RWMutex
+	structs    sync.Map // map[reflect.Type]*structChecks
+)
+
+// RegisterCheck registers a custom check under the given name.
+//
+// Custom checks must be registered before the first struct referring to them is validated, e.g., in an init function.
+// An error is returned if the name is empty, reserved for a built-in check or already registered.
+func RegisterCheck(name string, f CheckFunc) error {
+	if err := CheckArgumentf(isCheckName(name), "invalid check name: %q", name); err != nil {
+		return err
+	}
+	if err := CheckArgumentf(f != nil, "check %s must not be nil", name); err != nil {
+		return err
+	}
+
+	checkMu.Lock()
+	defer checkMu.Unlock()
+	_, dup := checkFuncs[name]
+	if err := CheckArgumentf(!dup && !builtinChecks[name], "check %s is already registered", name); err != nil {
+		return err
+	}
+	checkFuncs[name] = f
+	return nil
+}
+
+// builtinChecks contains the names of the checks supported by ValidateStruct out of the box.
+var builtinChecks = map[string]bool{"notnil": true, "notempty": true, "nonnegative": true, "index": true,
+	"position": true}
+
+// ValidateStruct validates the fields of the struct v (or the struct v points to) according to their struct tags.
+//
+// The tag "check" contains a comma-separated list of checks, which are applied to the field, e.g.:
+//
+//	type Page struct {
+//		Items  []Item `check:"notnil"`
+//		Offset int    `check:"nonnegative,position<=Total"`
+//		Total  int    `check:"nonnegative"`
+//	}
+//
+// The following checks are built in:
+//
+//   - notnil: the field (a pointer, interface, map, slice, channel or function) must not be nil (see CheckNotNil)
+//   - notempty: the field (a string, slice, map or array) must not be empty
+//   - nonnegative: the field (a number) must not be negative (see CheckNonnegative)
+//   - index<F: the field (an integer) must be a valid element index (see CheckElementIndex) for a size given by
+//     the field F, which is either an (unsigned) integer or a string, slice, map or array whose length is used
+//   - position<=F: the field must be a valid position index (see CheckPositionIndex) like above
+//
+// Additional checks can be registered using RegisterCheck and referred to by name, optionally followed by "=" and a
+// parameter, e.g., `check:"maxlen=3"`.
+//
+// Nested structs, pointers to structs as well as slices and arrays of them are validated recursively, unless the field
+// is tagged with `check:"-"`. Unexported fields are ignored. A struct, which is reachable through several pointers,
+// is validated only once, hence cyclic data structures like doubly-linked lists are supported.
+//
+// All failed checks are combined into a *ValidationError, in which each failure is identified by its field path,
+// e.g., "Items[2].Name". It returns nil if all checks pass.
+// An error is returned without validating any field, if v is not a struct or if any tag is invalid.
+// The tags are parsed once per type and cached.
+func ValidateStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	seen := visited{}
+	if rv.Kind() == reflect.Pointer {
+		if _, err := CheckNotNilf(v, "cannot validate nil %T", v); err != nil {
+			return err
+		}
+		seen[visitKey{rv.Pointer(), rv.Type()}] = struct{}{}
+		rv = rv.Elem()
+	}
+	if err := CheckArgumentf(rv.Kind() == reflect.Struct, "cannot validate %T: not a struct", v); err != nil {
+		return err
+	}
+
+	visiting := map[reflect.Type]*structChecks{}
+	sc, err := compileStruct(rv.Type(), visiting)
+	if err != nil {
+		return err
+	}
+	for t, sc := range visiting {
+		structs.Store(t, sc)
+	}
+
+	val := NewValidator()
+	sc.validate(rv, val, seen)
+	return val.Err()
+}
+
+// structChecks contains the checks of all fields of a struct type.
+type structChecks struct {
+	fields []fieldChecks
+	// compiled is true once all fields have been compiled, which is not the case while compiling recursive types.
+	compiled bool
+}
+
+// fieldChecks contains the checks of a single struct field.
+type fieldChecks struct {
+	index   int
+	name    string
+	checks  []func(sv, fv reflect.Value) error
+	recurse func(fv reflect.Value, val *Validator, seen visited)
+}
+
+// visitKey identifies a struct, which has been reached through a pointer, by its address and type.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// visited contains the structs, which have already been reached through pointers during a validation.
+// It prevents validating the same struct twice, which would never end for cyclic data structures.
+type visited map[visitKey]struct{}
+
+func (sc *structChecks) validate(sv reflect.Value, val *Validator, seen visited) {
+	for _, fc := range sc.fields {
+		fv := sv.Field(fc.index)
+		fval := val.Field(fc.name)
+		for _, c := range fc.checks {
+			fval.Check(c(sv, fv))
+		}
+		if fc.recurse != nil {
+			fc.recurse(fv, fval, seen)
+		}
+	}
+}
+
+// compileStruct parses the tags of the struct type t and returns its checks.
+// The checks of t and all nested struct types, which have not been cached yet, are added to visiting.
+func compileStruct(t reflect.Type, visiting map[reflect.Type]*structChecks) (*structChecks, error) {
+	if sc, ok := structs.Load(t); ok {
+		return sc.(*structChecks), nil
+	}
+	if sc, ok := visiting[t]; ok {
+		// nested or recursive type: sc is populated before it is used
+		return sc, nil
+	}
+	sc := &structChecks{}
+	visiting[t] = sc
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, tagged := f.Tag.Lookup("check")
+		if !f.IsExported() {
+			if err := CheckArgumentf(!tagged, "field %s.%s is not exported", t, f.Name); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		fc := fieldChecks{index: i, name: f.Name}
+		if tag == "-" {
+			continue
+		}
+		if tag != "" {
+			for _, spec := range strings.Split(tag, ",") {
+				c, err := compileCheck(t, f, strings.TrimSpace(spec))
+				if err != nil {
+					return nil, err
+				}
+				fc.checks = append(fc.checks, c)
+			}
+		}
+
+		rec, err := compileRecursion(f.Type, visiting)
+		if err != nil {
+			return nil, err
+		}
+		fc.recurse = rec
+		if len(fc.checks) > 0 || fc.recurse != nil {
+			sc.fields = append(sc.fields, fc)
+		}
+	}
+	sc.compiled = true
+	return sc, nil
+}
+
+// compileRecursion returns a function, which validates the nested structs contained in values of type t, or nil if
+// values of type t cannot contain any structs to validate.
+func compileRecursion(t reflect.Type, visiting map[reflect.Type]*structChecks) (
+	func(reflect.Value, *Validator, visited), error) {
+
+	switch t.Kind() {
+	case reflect.Struct:
+		sc, err := compileStruct(t, visiting)
+		if err != nil || (sc.compiled && len(sc.fields) == 0) {
+			return nil, err
+		}
+		return func(v reflect.Value, val *Validator, seen visited) { sc.validate(v, val, seen) }, nil
+	case reflect.Pointer:
+		if t.Elem().Kind() != reflect.Struct {
+			return nil, nil
+		}
+		rec, err := compileRecursion(t.Elem(), visiting)
+		if err != nil || rec == nil {
+			return nil, err
+		}
+		return func(v reflect.Value, val *Validator, seen visited) {
+			if v.IsNil() {
+				return
+			}
+			k := visitKey{v.Pointer(), t}
+			if _, ok := seen[k]; ok {
+				return
+			}
+			seen[k] = struct{}{}
+			rec(v.Elem(), val, seen)
+		}, nil
+	case reflect.Slice, reflect.Array:
+		rec, err := compileRecursion(t.Elem(), visiting)
+		if err != nil || rec == nil {
+			return nil, err
+		}
+		return func(v reflect.Value, val *Validator, seen visited) {
+			for i := 0; i < v.Len(); i++ {
+				rec(v.Index(i), val.Index(i), seen)
+			}
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// compileCheck parses a single check of the field f of the struct type t.
+func compileCheck(t reflect.Type, f reflect.StructField, spec string) (func(sv, fv reflect.Value) error, error) {
+	name, op, param := parseCheck(spec)
+	k := f.Type.Kind()
+	invalid := func() error {
+		return CheckArgumentf(false, "check %q cannot be applied to field %s.%s of type %s", spec, t, f.Name, f.Type)
+	}
+
+	switch name {
+	case "notnil":
+		if op != "" || !isNillable(k) {
+			return nil, invalid()
+		}
+		return func(_, fv reflect.Value) error {
+			_, err := CheckNotNilf(fv.Interface(), "must not be nil")
+			return err
+		}, nil
+	case "notempty":
+		if op != "" || !hasLen(k) || k == reflect.Chan {
+			return nil, invalid()
+		}
+		return func(_, fv reflect.Value) error {
+			return CheckArgumentf(fv.Len() > 0, "must not be empty")
+		}, nil
+	case "nonnegative":
+		switch {
+		case op != "":
+			return nil, invalid()
+		case isInt(k):
+			return func(_, fv reflect.Value) error {
+				_, err := CheckNonnegative64(fv.Int(), "value")
+				return err
+			}, nil
+		case isUint(k):
+			return func(_, _ reflect.Value) error { return nil }, nil
+		case k == reflect.Float32 || k == reflect.Float64:
+			return func(_, fv reflect.Value) error {
+				return CheckArgumentf(!(fv.Float() < 0), "value cannot be negative but was: %v", fv.Float())
+			}, nil
+		default:
+			return nil, invalid()
+		}
+	case "index", "position":
+		if (name == "index" && op != "<") || (name == "position" && op != "<=") || !isInt(k) {
+			return nil, invalid()
+		}
+		size, err := compileSize(t, param)
+		if err != nil {
+			return nil, err
+		}
+		if name == "index" {
+			return func(sv, fv reflect.Value) error {
+				_, err := CheckElementIndexf(int(fv.Int()), size(sv), "index")
+				return err
+			}, nil
+		}
+		return func(sv, fv reflect.Value) error {
+			_, err := CheckPositionIndexf(int(fv.Int()), size(sv), "index")
+			return err
+		}, nil
+	}
+
+	checkMu.RLock()
+	cf, ok := checkFuncs[name]
+	checkMu.RUnlock()
+	if err := CheckArgumentf(ok, "unknown check %q of field %s.%s", spec, t, f.Name); err != nil {
+		return nil, err
+	}
+	if op != "" && op != "=" {
+		return nil, invalid()
+	}
+	return func(_, fv reflect.Value) error { return cf(fv, param) }, nil
+}
+
+// compileSize returns a function, which determines the size given by the field with the given name of the struct
+// type t.
+func compileSize(t reflect.Type, name string) (func(sv reflect.Value) int, error) {
+	f, ok := t.FieldByName(name)
+	if err := CheckArgumentf(ok && len(f.Index) == 1, "unknown size field %s.%s", t, name); err != nil {
+		return nil, err
+	}
+	switch k := f.Type.Kind(); {
+	case isInt(k):
+		return func(sv reflect.Value) int { return int(sv.Field(f.Index[0]).Int()) }, nil
+	case isUint(k):
+		return func(sv reflect.Value) int { return int(sv.Field(f.Index[0]).Uint()) }, nil
+	case hasLen(k):
+		return func(sv reflect.Value) int { return sv.Field(f.Index[0]).Len() }, nil
+	default:
+		return nil, CheckArgumentf(false, "size field %s.%s of type %s has no size", t, name, f.Type)
+	}
+}
+
+// parseCheck splits a check like "index<Len" or "maxlen=3" into its name, operator and parameter.
+func parseCheck(spec string) (name, op, param string) {
+	i := strings.IndexAny(spec, "<=")
+	if i < 0 {
+		return spec, "", ""
+	}
+	name, op, param = spec[:i], spec[i:i+1], spec[i+1:]
+	if op == "<" && strings.HasPrefix(param, "=") {
+		op, param = "<=", param[1:]
+	}
+	return name, op, param
+}
+
+func isCheckName(name string) bool {
+	return name != "" && name != "-" && !strings.ContainsAny(name, "<=, ")
+}
+
+func isInt(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUint(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func isNillable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasLen(k reflect.Kind) bool {
+	switch k {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precond_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	. "github.com/abc-inc/goava/base/precond"
+	. "github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name  string  `check:"notempty"`
+	Qty   int     `check:"nonnegative"`
+	Price float64 `check:"nonnegative"`
+}
+
+type page struct {
+	Items    []item  `check:"notnil"`
+	Featured *item   `check:"notnil"`
+	Related  []*item `check:"-"`
+	Offset   int     `check:"nonnegative,position<=Total"`
+	Selected int     `check:"index<Items"`
+	Total    uint    `check:"nonnegative"`
+	Tags     [2]string
+	Parent   *page
+	hidden   *int
+}
+
+func TestValidateStruct(t *testing.T) {
+	p := page{
+		Items:    []item{{"a", 1, 0.5}},
+		Featured: &item{"b", 0, 0},
+		Related:  []*item{{}},
+		Offset:   1,
+		Selected: 0,
+		Total:    1,
+		Parent:   &page{Items: []item{}, Featured: &item{Name: "c"}, Selected: -1},
+	}
+	EqualError(t, ValidateStruct(p), "Parent.Selected: index (-1) must not be negative")
+
+	p.Parent.Selected = 0
+	EqualError(t, ValidateStruct(&p), "Parent.Selected: index (0) must be less than size (0)")
+
+	p.Parent = nil
+	NoError(t, ValidateStruct(p))
+	NoError(t, ValidateStruct(&p))
+}
+
+func TestValidateStruct_Errors(t *testing.T) {
+	p := page{
+		Items:    []item{{"a", 1, 0}, {"", -1, -0.5}},
+		Related:  []*item{{}},
+		Offset:   3,
+		Selected: 2,
+		Total:    2,
+	}
+
+	err := ValidateStruct(&p)
+	EqualError(t, err, "Items[1].Name: must not be empty\n"+
+		"Items[1].Qty: value cannot be negative but was: -1\n"+
+		"Items[1].Price: value cannot be negative but was: -0.5\n"+
+		"Featured: must not be nil\n"+
+		"Offset: index (3) must not be greater than size (2)\n"+
+		"Selected: index (2) must be less than size (2)")
+	ErrorIs(t, err, ErrNil)
+	ErrorIs(t, err, ErrIllegalArgument)
+	ErrorIs(t, err, ErrIndexOutOfBounds)
+
+	var ve *ValidationError
+	ErrorAs(t, err, &ve)
+	Len(t, ve.Errors, 6)
+}
+
+type node struct {
+	Val  int `check:"nonnegative"`
+	Prev *node
+	Next *node
+}
+
+func TestValidateStruct_Cycle(t *testing.T) {
+	a, b, c := &node{Val: 1}, &node{Val: 2}, &node{Val: 3}
+	a.Next, b.Prev, b.Next, c.Prev, c.Next, a.Prev = b, a, c, b, a, c
+	NoError(t, ValidateStruct(a))
+	NoError(t, ValidateStruct(*a))
+
+	b.Val = -1
+	EqualError(t, ValidateStruct(a), "Prev.Prev.Val: value cannot be negative but was: -1")
+	EqualError(t, ValidateStruct(c), "Prev.Val: value cannot be negative but was: -1")
+
+	self := &node{Val: -1}
+	self.Prev, self.Next = self, self
+	EqualError(t, ValidateStruct(self), "Val: value cannot be negative but was: -1")
+}
+
+func TestValidateStruct_InvalidArgument(t *testing.T) {
+	EqualError(t, ValidateStruct(1), "cannot validate int: not a struct")
+	EqualError(t, ValidateStruct((*page)(nil)), "cannot validate nil *precond_test.page")
+	EqualError(t, ValidateStruct(nil), "cannot validate <nil>: not a struct")
+}
+
+func TestValidateStruct_InvalidTag(t *testing.T) {
+	tests := []struct {
+		v   interface{}
+		msg string
+	}{
+		{struct {
+			A int `check:"notnil"`
+		}{}, `check "notnil" cannot be applied to field struct { A int "check:\"notnil\"" }.A of type int`},
+		{struct {
+			A string `check:"nonnegative"`
+		}{}, `check "nonnegative" cannot be applied to field struct { A string "check:\"nonnegative\"" }.A of type string`},
+		{struct {
+			A int `check:"index<=B"`
+			B int
+		}{}, `check "index<=B" cannot be applied to field struct { A int "check:\"index<=B\""; B int }.A of type int`},
+		{struct {
+			A int `check:"index<B"`
+		}{}, `unknown size field struct { A int "check:\"index<B\"" }.B`},
+		{struct {
+			A int `check:"position<=B"`
+			B bool
+		}{}, `size field struct { A int "check:\"position<=B\""; B bool }.B of type bool has no size`},
+		{struct {
+			A int `check:"unknown"`
+		}{}, `unknown check "unknown" of field struct { A int "check:\"unknown\"" }.A`},
+		{struct {
+			a int `check:"nonnegative"`
+		}{}, `field struct { a int "check:\"nonnegative\"" }.a is not exported`},
+	}
+
+	for _, tc := range tests {
+		err := ValidateStruct(tc.v)
+		Error(t, err)
+		Equal(t, tc.msg, err.Error())
+	}
+}
+
+func TestRegisterCheck(t *testing.T) {
+	maxLen := func(v reflect.Value, param string) error {
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return err
+		}
+		return CheckArgumentf(v.Len() <= n, "length must not exceed %d", n)
+	}
+	NoError(t, RegisterCheck("maxlen", maxLen))
+	EqualError(t, RegisterCheck("maxlen", maxLen), "check maxlen is already registered")
+	EqualError(t, RegisterCheck("notnil", maxLen), "check notnil is already registered")
+	EqualError(t, RegisterCheck("a<b", maxLen), `invalid check name: "a<b"`)
+	EqualError(t, RegisterCheck("nilcheck", nil), "check nilcheck must not be nil")
+
+	type user struct {
+		Name  string   `check:"notempty,maxlen=3"`
+		Roles []string `check:"maxlen=1"`
+	}
+	NoError(t, ValidateStruct(user{"bob", nil}))
+	EqualError(t, ValidateStruct(user{"alice", []string{"a", "b"}}),
+		"Name: length must not exceed 3\nRoles: length must not exceed 1")
+}
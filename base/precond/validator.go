@@ -0,0 +1,189 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precond
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// FieldError is a failed check of a single field, which is identified by its path, e.g., "items[2].name".
+type FieldError struct {
+	// Path is the path of the field, which is empty for checks of the validated value itself.
+	Path string
+	// Err is the error returned by the failed check.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error returned by the failed check.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}{e.Path, e.Err.Error()})
+}
+
+// ValidationError is the combination of all failed checks recorded by a Validator.
+//
+// Like an error returned by errors.Join, its message consists of the messages of the individual errors separated by
+// newlines, and it can be inspected by means of errors.Is and errors.As.
+type ValidationError struct {
+	// Errors contains the failed checks in the order in which they were recorded.
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the individual errors.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fe := range e.Errors {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The error is encoded as an object containing an array of fields and messages, e.g.:
+//
+//	{"errors":[{"field":"name","message":"must not be empty"}]}
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*FieldError `json:"errors"`
+	}{e.Errors})
+}
+
+// Validator accumulates the errors returned by checks, such as CheckArgumentf or CheckElementIndex, instead of
+// stopping at the first one.
+//
+// Each error is recorded along with the path of the field it belongs to, which is built by Field and Index, e.g.:
+//
+//	v := precond.NewValidator()
+//	v.Field("name").Check(precond.CheckArgumentf(r.Name != "", "must not be empty"))
+//	for i, it := range r.Items {
+//		v.Field("items").Index(i).Field("qty").CheckValue(precond.CheckNonnegative(it.Qty, "qty"))
+//	}
+//	return v.Err()
+//
+// The zero value is an empty Validator ready to use.
+type Validator struct {
+	path string
+	errs *ValidationError
+}
+
+// NewValidator returns a Validator without any errors.
+func NewValidator() *Validator {
+	return &Validator{errs: &ValidationError{}}
+}
+
+// Field returns a Validator for the field with the given name, which records its errors in this Validator.
+func (v *Validator) Field(name string) *Validator {
+	if v.path == "" {
+		return &Validator{name, v.recorded()}
+	}
+	return &Validator{v.path + "." + name, v.recorded()}
+}
+
+// Index returns a Validator for the element at the given index, which records its errors in this Validator.
+func (v *Validator) Index(i int) *Validator {
+	return &Validator{v.path + "[" + strconv.Itoa(i) + "]", v.recorded()}
+}
+
+// Check records the error, unless it is nil, and reports whether it was nil.
+//
+// If err is a *ValidationError, e.g., returned by another Validator, its errors are recorded individually relative
+// to the path of this Validator.
+func (v *Validator) Check(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		for _, fe := range ve.Errors {
+			v.relative(fe.Path).Check(fe.Err)
+		}
+		return false
+	}
+	errs := v.recorded()
+	errs.Errors = append(errs.Errors, &FieldError{v.path, err})
+	return false
+}
+
+// CheckValue records the error, unless it is nil, and reports whether it was nil.
+// It discards the value, which allows passing the results of checks like CheckNotNil or CheckElementIndex directly.
+func (v *Validator) CheckValue(_ interface{}, err error) bool {
+	return v.Check(err)
+}
+
+// CheckField records the error for the field with the given name, unless it is nil, and reports whether it was nil.
+// It is a shortcut for Field(name).Check(err).
+func (v *Validator) CheckField(name string, err error) bool {
+	return v.Field(name).Check(err)
+}
+
+// Valid reports whether no errors have been recorded.
+func (v *Validator) Valid() bool {
+	return v.errs == nil || len(v.errs.Errors) == 0
+}
+
+// Err returns a *ValidationError containing all recorded errors, or nil if no errors have been recorded.
+func (v *Validator) Err() error {
+	if v.Valid() {
+		return nil
+	}
+	return &ValidationError{append([]*FieldError(nil), v.errs.Errors...)}
+}
+
+// relative returns a Validator for the given path relative to this Validator, which is either empty, a field path
+// or an index path like "[0].qty".
+func (v *Validator) relative(path string) *Validator {
+	switch {
+	case path == "":
+		return v
+	case v.path == "" || strings.HasPrefix(path, "["):
+		return &Validator{v.path + path, v.recorded()}
+	default:
+		return &Validator{v.path + "." + path, v.recorded()}
+	}
+}
+
+// recorded returns the errors recorded by this Validator, initializing them if necessary.
+func (v *Validator) recorded() *ValidationError {
+	if v.errs == nil {
+		v.errs = &ValidationError{}
+	}
+	return v.errs
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precond_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/abc-inc/goava/base/precond"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestValidator_Valid(t *testing.T) {
+	v := NewValidator()
+	True(t, v.Check(nil))
+	True(t, v.CheckField("name", CheckArgument(true)))
+	True(t, v.Field("items").Index(0).CheckValue(CheckElementIndex(0, 1)))
+	True(t, v.Valid())
+	NoError(t, v.Err())
+}
+
+func TestValidator_Err(t *testing.T) {
+	v := NewValidator()
+	False(t, v.Check(CheckState(false)))
+	False(t, v.CheckField("name", CheckArgumentf(false, "must not be empty")))
+	items := v.Field("items")
+	False(t, items.Index(2).Field("qty").CheckValue(CheckNonnegative(-1, "qty")))
+	False(t, items.CheckValue(CheckElementIndex(3, 3)))
+	False(t, v.Valid())
+
+	err := v.Err()
+	EqualError(t, err, "illegal state\n"+
+		"name: must not be empty\n"+
+		"items[2].qty: qty cannot be negative but was: -1\n"+
+		"items: index (3) must be less than size (3)")
+
+	ErrorIs(t, err, ErrIllegalState)
+	ErrorIs(t, err, ErrIllegalArgument)
+	ErrorIs(t, err, ErrIndexOutOfBounds)
+	NotErrorIs(t, err, ErrNil)
+
+	var ve *ValidationError
+	ErrorAs(t, err, &ve)
+	Len(t, ve.Errors, 4)
+	Equal(t, "items[2].qty", ve.Errors[2].Path)
+
+	var fe *FieldError
+	ErrorAs(t, err, &fe)
+	Equal(t, "", fe.Path)
+
+	// errors recorded afterwards do not affect the returned error
+	v.Check(CheckArgument(false))
+	Len(t, ve.Errors, 4)
+}
+
+func TestValidator_Nested(t *testing.T) {
+	validateAddress := func(city string) error {
+		v := NewValidator()
+		v.Check(CheckArgumentf(city != "Atlantis", "unknown city"))
+		v.CheckField("city", CheckArgumentf(city != "", "must not be empty"))
+		return v.Err()
+	}
+
+	v := NewValidator()
+	v.Field("address").Check(validateAddress(""))
+	v.Field("addresses").Index(1).Check(validateAddress("Atlantis"))
+	v.Field("other").Check(fmt.Errorf("wrapped: %w", validateAddress("")))
+	EqualError(t, v.Err(), "address.city: must not be empty\n"+
+		"addresses[1]: unknown city\n"+
+		"other.city: must not be empty")
+}
+
+func TestValidator_NestedIndex(t *testing.T) {
+	validateItems := func(qtys ...int) error {
+		v := NewValidator()
+		for i, q := range qtys {
+			v.Index(i).CheckField("qty", CheckArgumentf(q > 0, "must be positive"))
+		}
+		return v.Err()
+	}
+
+	v := NewValidator()
+	v.Field("items").Check(validateItems(1, 0))
+	v.Check(validateItems(-1))
+	v.Field("orders").Index(2).Field("items").Check(validateItems(0))
+	EqualError(t, v.Err(), "items[1].qty: must be positive\n"+
+		"[0].qty: must be positive\n"+
+		"orders[2].items[0].qty: must be positive")
+}
+
+func TestValidator_Zero(t *testing.T) {
+	var v Validator
+	True(t, v.Valid())
+	NoError(t, v.Err())
+
+	v.Field("a").Check(CheckArgumentf(false, "x"))
+	v.Index(1).Check(CheckArgumentf(false, "y"))
+	v.Check(CheckArgumentf(false, "z"))
+	False(t, v.Valid())
+	EqualError(t, v.Err(), "a: x\n[1]: y\nz")
+
+	var w Validator
+	w.Check(v.Err())
+	EqualError(t, w.Err(), "a: x\n[1]: y\nz")
+}
+
+func TestValidator_Join(t *testing.T) {
+	v := NewValidator()
+	v.CheckField("a", CheckArgumentf(false, "x"))
+	v.CheckField("b", CheckArgumentf(false, "y"))
+	Equal(t, errors.Join(errors.New("a: x"), errors.New("b: y")).Error(), v.Err().Error())
+
+	joined := errors.Join(v.Err(), errors.New("z"))
+	ErrorIs(t, joined, ErrIllegalArgument)
+}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	v := NewValidator()
+	v.Check(CheckArgumentf(false, "invalid request"))
+	v.Field("items").Index(0).CheckField("id", CheckArgumentf(false, "must be positive"))
+
+	data, err := json.Marshal(v.Err())
+	NoError(t, err)
+	JSONEq(t, `{"errors":[
+		{"field":"","message":"invalid request"},
+		{"field":"items[0].id","message":"must be positive"}
+	]}`, string(data))
+}
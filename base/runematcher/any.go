@@ -14,9 +14,10 @@
 
 package runematcher
 
-//go:generate go run gen.go any
-
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // anyMatcher matches any character.
 type anyMatcher struct {
@@ -27,14 +28,26 @@ func (m anyMatcher) Matches(r rune) bool {
 	return true
 }
 
-// IndexIn returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
+// IndexIn returns the byte offset of the first matching character in a character sequence,
+// starting from a given byte offset, or -1 if no character matches after that position.
 func (m anyMatcher) IndexIn(str string, start int) int {
-	return m.IndexInRunes([]rune(str), start)
+	if start < 0 || start >= len(str) {
+		return -1
+	}
+	return start
+}
+
+// RuneIndexIn returns the rune offset of the first matching character in a character sequence,
+// starting from a given rune offset, or -1 if no character matches after that position.
+func (m anyMatcher) RuneIndexIn(str string, start int) int {
+	if start < 0 || start >= utf8.RuneCountInString(str) {
+		return -1
+	}
+	return start
 }
 
-// IndexInRunes returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
+// IndexInRunes returns the index of the first matching character in a rune slice,
+// starting from a given index, or -1 if no character matches after that position.
 func (m anyMatcher) IndexInRunes(runes []rune, start int) int {
 	if start < 0 || start >= len(runes) {
 		return -1
@@ -42,10 +55,26 @@ func (m anyMatcher) IndexInRunes(runes []rune, start int) int {
 	return start
 }
 
-// LastIndexIn returns the index of the last matching character in a character sequence,
+// LastIndexIn returns the byte offset of the last matching character in a character sequence,
 // or -1 if no matching character is present.
 func (m anyMatcher) LastIndexIn(str string) int {
-	return len(str) - 1
+	if len(str) == 0 {
+		return -1
+	}
+	_, size := utf8.DecodeLastRuneInString(str)
+	return len(str) - size
+}
+
+// RuneLastIndexIn returns the rune offset of the last matching character in a character sequence,
+// or -1 if no matching character is present.
+func (m anyMatcher) RuneLastIndexIn(str string) int {
+	return utf8.RuneCountInString(str) - 1
+}
+
+// LastIndexInRunes returns the index of the last matching character in a rune slice,
+// or -1 if no matching character is present.
+func (m anyMatcher) LastIndexInRunes(runes []rune) int {
+	return len(runes) - 1
 }
 
 // MatchesAllOf returns true if a character sequence contains only matching characters.
@@ -68,18 +97,13 @@ func (m anyMatcher) RemoveFrom(str string) string {
 // ReplaceFromRune returns a string copy of the input character sequence, with each matching character
 // replaced by a given replacement character.
 func (m anyMatcher) ReplaceFromRune(str string, replacement rune) string {
-	return strings.Repeat(string(replacement), len(str))
+	return strings.Repeat(string(replacement), utf8.RuneCountInString(str))
 }
 
 // ReplaceFrom returns a string copy of the input character sequence, with each matching character
 // replaced by a given replacement sequence.
 func (m anyMatcher) ReplaceFrom(str, replacement string) string {
-	result := strings.Builder{}
-	result.Grow(len(str) * len(replacement))
-	for i := 0; i < len(str); i++ {
-		result.WriteString(replacement)
-	}
-	return result.String()
+	return strings.Repeat(replacement, utf8.RuneCountInString(str))
 }
 
 // CollapseFrom returns a string copy of the input character sequence, with each group of consecutive matching
@@ -99,7 +123,7 @@ func (m anyMatcher) TrimFrom(str string) string {
 
 // CountIn returns the number of matching characters found in a character sequence.
 func (m anyMatcher) CountIn(str string) int {
-	return len(str)
+	return utf8.RuneCountInString(str)
 }
 
 // And returns a matcher that matches any character matched by both this matcher and other.
@@ -109,7 +133,7 @@ func (m anyMatcher) And(other Matcher) Matcher {
 
 // Or returns a matcher that matches any character matched by either this matcher or other.
 func (m anyMatcher) Or(other Matcher) Matcher {
-	return m
+	return Any()
 }
 
 // Negate returns a matcher that matches any character not matched by this matcher.
@@ -121,35 +145,3 @@ func (m anyMatcher) Negate() Matcher {
 func (m anyMatcher) String() string {
 	return "Matcher.any()"
 }
-
-// Code generated. DO NOT EDIT.
-
-// MatchesAnyOf returns true if a character sequence contains at least one matching character.
-//
-// Equivalent to !MatchesNoneOf(sequence)
-func (m anyMatcher) MatchesAnyOf(str string) bool {
-	return matchesAnyOf(m, str)
-}
-
-// RetainFrom returns a string containing all matching characters of a character sequence, in order.
-func (m anyMatcher) RetainFrom(str string) string {
-	return retainFrom(m, str)
-}
-
-// TrimLeadingFrom returns a substring of the input character sequence that omits all matching characters
-// from the beginning of the string.
-func (m anyMatcher) TrimLeadingFrom(str string) string {
-	return trimLeadingFrom(m, str)
-}
-
-// TrimTrailingFrom returns a substring of the input character sequence that omits all matching characters
-// from the end of the string.
-func (m anyMatcher) TrimTrailingFrom(str string) string {
-	return trimTrailingFrom(m, str)
-}
-
-// TrimAndCollapseFrom collapses groups of matching characters exactly as CollapseFrom(str, replacement) does,
-// except that groups of matching characters at the start or end of the sequence are removed without replacement.
-func (m anyMatcher) TrimAndCollapseFrom(str string, replacement rune) string {
-	return trimAndCollapseFrom(m, str, replacement)
-}
@@ -16,8 +16,6 @@ package runematcher
 
 import "unicode"
 
-//go:generate go run gen.go ascii
-
 // asciiMatcher determines whether a character is ASCII, meaning that its code point is less than 128.
 type asciiMatcher struct {
 }
@@ -31,114 +29,3 @@ func (m asciiMatcher) Matches(r rune) bool {
 func (m asciiMatcher) String() string {
 	return "Matcher.ascii()"
 }
-
-// Code generated. DO NOT EDIT.
-
-// Negate returns a matcher that matches any character not matched by this matcher.
-func (m asciiMatcher) Negate() Matcher {
-	return negate(m)
-}
-
-// And returns a matcher that matches any character matched by both this matcher and other.
-func (m asciiMatcher) And(other Matcher) Matcher {
-	return and(m, other)
-}
-
-// Or returns a matcher that matches any character matched by either this matcher or other.
-func (m asciiMatcher) Or(other Matcher) Matcher {
-	return or(m, other)
-}
-
-// MatchesAnyOf returns true if a character sequence contains at least one matching character.
-//
-// Equivalent to !MatchesNoneOf(sequence)
-func (m asciiMatcher) MatchesAnyOf(str string) bool {
-	return matchesAnyOf(m, str)
-}
-
-// MatchesAllOf returns true if a character sequence contains only matching characters.
-func (m asciiMatcher) MatchesAllOf(str string) bool {
-	return matchesAllOf(m, str)
-}
-
-// MatchesNoneOf returns true if a character sequence contains no matching characters.
-//
-// Equivalent to !MatchesAnyOf(sequence).
-func (m asciiMatcher) MatchesNoneOf(str string) bool {
-	return matchesNoneOf(m, str)
-}
-
-// IndexIn returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
-func (m asciiMatcher) IndexIn(str string, start int) int {
-	return indexIn(m, str, start)
-}
-
-// IndexInRunes returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
-func (m asciiMatcher) IndexInRunes(runes []rune, start int) int {
-	return indexInRunes(m, runes, start)
-}
-
-// LastIndexIn returns the index of the last matching character in a character sequence,
-// or -1 if no matching character is present.
-func (m asciiMatcher) LastIndexIn(str string) int {
-	return lastIndexIn(m, str)
-}
-
-// CountIn returns the number of matching characters found in a character sequence.
-func (m asciiMatcher) CountIn(str string) int {
-	return countIn(m, str)
-}
-
-// RemoveFrom returns a string containing all non-matching characters of a character sequence, in order.
-func (m asciiMatcher) RemoveFrom(str string) string {
-	return removeFrom(m, str)
-}
-
-// RetainFrom returns a string containing all matching characters of a character sequence, in order.
-func (m asciiMatcher) RetainFrom(str string) string {
-	return retainFrom(m, str)
-}
-
-// ReplaceFromRune returns a string copy of the input character sequence, with each matching character
-// replaced by a given replacement character.
-func (m asciiMatcher) ReplaceFromRune(str string, replacement rune) string {
-	return replaceFromRune(m, str, replacement)
-}
-
-// ReplaceFrom returns a string copy of the input character sequence, with each matching character
-// replaced by a given replacement sequence.
-func (m asciiMatcher) ReplaceFrom(str string, replacement string) string {
-	return replaceFrom(m, str, replacement)
-}
-
-// TrimFrom returns a substring of the input character sequence that omits all matching characters
-// from the beginning and from the end of the string.
-func (m asciiMatcher) TrimFrom(str string) string {
-	return trimFrom(m, str)
-}
-
-// TrimLeadingFrom returns a substring of the input character sequence that omits all matching characters
-// from the beginning of the string.
-func (m asciiMatcher) TrimLeadingFrom(str string) string {
-	return trimLeadingFrom(m, str)
-}
-
-// TrimTrailingFrom returns a substring of the input character sequence that omits all matching characters
-// from the end of the string.
-func (m asciiMatcher) TrimTrailingFrom(str string) string {
-	return trimTrailingFrom(m, str)
-}
-
-// CollapseFrom returns a string copy of the input character sequence, with each group of consecutive matching
-// characters replaced by a single replacement character.
-func (m asciiMatcher) CollapseFrom(str string, replacement rune) string {
-	return collapseFrom(m, str, replacement)
-}
-
-// TrimAndCollapseFrom collapses groups of matching characters exactly as CollapseFrom(str, replacement) does,
-// except that groups of matching characters at the start or end of the sequence are removed without replacement.
-func (m asciiMatcher) TrimAndCollapseFrom(str string, replacement rune) string {
-	return trimAndCollapseFrom(m, str, replacement)
-}
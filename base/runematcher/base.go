@@ -0,0 +1,315 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+// Predicate determines a true or false value for any rune and describes itself, e.g., "Matcher.vowel()".
+// It is the minimal definition of a Matcher, which is turned into a full Matcher by FromPredicate.
+type Predicate interface {
+	// Matches determines a true or false value for the given character.
+	Matches(r rune) bool
+
+	// String returns a string representation of this Predicate.
+	String() string
+}
+
+// FromPredicate returns a Matcher, which determines a true or false value for any rune by means of the given Predicate, and
+// which offers all text processing methods of Matcher based on it.
+//
+// If the Predicate implements other methods of Matcher (with identical signatures), e.g., CountIn(str string) int
+// for a faster implementation, or Negate() Matcher for a simpler negation, they are used instead of the defaults.
+// Implementations are strongly encouraged to be side-effect-free and immutable.
+func FromPredicate[P Predicate](p P) Matcher {
+	if m, ok := any(p).(Matcher); ok {
+		return m
+	}
+	return matcher[P]{p}
+}
+
+// Func returns a Matcher with identical behavior to the given character-based predicate, which is described by desc.
+//
+// It is equivalent to ForPredicate, except for the string representation.
+func Func(desc string, f func(rune) bool) Matcher {
+	return FromPredicate(funcPredicate{desc, f})
+}
+
+// funcPredicate is a Predicate based on a function.
+type funcPredicate struct {
+	desc string
+	f    func(rune) bool
+}
+
+// Matches determines a true or false value for the given character.
+func (p funcPredicate) Matches(r rune) bool {
+	return p.f(r)
+}
+
+// String returns a string representation of this Predicate.
+func (p funcPredicate) String() string {
+	return p.desc
+}
+
+// matcher implements all methods of Matcher by means of a Predicate, which may override any of them.
+type matcher[P Predicate] struct {
+	p P
+}
+
+// predicateOf returns the Predicate underlying m, if m has been created by FromPredicate; otherwise, m itself.
+func predicateOf(m Matcher) Predicate {
+	if w, ok := m.(interface{ predicate() Predicate }); ok {
+		return w.predicate()
+	}
+	return m
+}
+
+func (m matcher[P]) predicate() Predicate {
+	return m.p
+}
+
+// Matches determines a true or false value for the given character.
+func (m matcher[P]) Matches(r rune) bool {
+	return m.p.Matches(r)
+}
+
+// String returns a string representation of this Matcher.
+func (m matcher[P]) String() string {
+	return m.p.String()
+}
+
+// Negate returns a matcher that matches any character not matched by this matcher.
+func (m matcher[P]) Negate() Matcher {
+	if o, ok := any(m.p).(interface{ Negate() Matcher }); ok {
+		return o.Negate()
+	}
+	return negate(m)
+}
+
+// And returns a matcher that matches any character matched by both this matcher and other.
+func (m matcher[P]) And(other Matcher) Matcher {
+	if o, ok := any(m.p).(interface{ And(other Matcher) Matcher }); ok {
+		return o.And(other)
+	}
+	return and(m, other)
+}
+
+// Or returns a matcher that matches any character matched by either this matcher or other.
+func (m matcher[P]) Or(other Matcher) Matcher {
+	if o, ok := any(m.p).(interface{ Or(other Matcher) Matcher }); ok {
+		return o.Or(other)
+	}
+	return or(m, other)
+}
+
+// Precomputed returns a matcher functionally equivalent to this one, but which may be faster to query than the
+// original; your mileage may vary. Precomputation takes time and is likely to be worthwhile only if the
+// precomputed matcher is queried many thousands of times.
+func (m matcher[P]) Precomputed() Matcher {
+	if o, ok := any(m.p).(interface{ Precomputed() Matcher }); ok {
+		return o.Precomputed()
+	}
+	return precomputed(m)
+}
+
+// ToCharClass returns a regular expression character class in the syntax of the regexp package, which matches
+// the same characters as this matcher, e.g., [a-z] or [\p{Lu}\p{Nd}].
+// Matchers based on arbitrary predicates (see ForPredicate) cannot be represented as a character class.
+func (m matcher[P]) ToCharClass() (string, error) {
+	if o, ok := any(m.p).(interface{ ToCharClass() (string, error) }); ok {
+		return o.ToCharClass()
+	}
+	return toCharClass(m)
+}
+
+// MatchesAnyOf returns true if a character sequence contains at least one matching character.
+//
+// Equivalent to !MatchesNoneOf(sequence)
+func (m matcher[P]) MatchesAnyOf(str string) bool {
+	if o, ok := any(m.p).(interface{ MatchesAnyOf(str string) bool }); ok {
+		return o.MatchesAnyOf(str)
+	}
+	return matchesAnyOf(m, str)
+}
+
+// MatchesAllOf returns true if a character sequence contains only matching characters.
+func (m matcher[P]) MatchesAllOf(str string) bool {
+	if o, ok := any(m.p).(interface{ MatchesAllOf(str string) bool }); ok {
+		return o.MatchesAllOf(str)
+	}
+	return matchesAllOf(m, str)
+}
+
+// MatchesNoneOf returns true if a character sequence contains no matching characters.
+//
+// Equivalent to !MatchesAnyOf(sequence).
+func (m matcher[P]) MatchesNoneOf(str string) bool {
+	if o, ok := any(m.p).(interface{ MatchesNoneOf(str string) bool }); ok {
+		return o.MatchesNoneOf(str)
+	}
+	return matchesNoneOf(m, str)
+}
+
+// IndexIn returns the byte offset of the first matching character in a character sequence,
+// starting from a given byte offset, or -1 if no character matches after that position.
+func (m matcher[P]) IndexIn(str string, start int) int {
+	if o, ok := any(m.p).(interface {
+		IndexIn(str string, start int) int
+	}); ok {
+		return o.IndexIn(str, start)
+	}
+	return indexIn(m, str, start)
+}
+
+// RuneIndexIn returns the rune offset of the first matching character in a character sequence,
+// starting from a given rune offset, or -1 if no character matches after that position.
+func (m matcher[P]) RuneIndexIn(str string, start int) int {
+	if o, ok := any(m.p).(interface {
+		RuneIndexIn(str string, start int) int
+	}); ok {
+		return o.RuneIndexIn(str, start)
+	}
+	return runeIndexIn(m, str, start)
+}
+
+// IndexInRunes returns the index of the first matching character in a rune slice,
+// starting from a given index, or -1 if no character matches after that position.
+func (m matcher[P]) IndexInRunes(runes []rune, start int) int {
+	if o, ok := any(m.p).(interface {
+		IndexInRunes(runes []rune, start int) int
+	}); ok {
+		return o.IndexInRunes(runes, start)
+	}
+	return indexInRunes(m, runes, start)
+}
+
+// LastIndexIn returns the byte offset of the last matching character in a character sequence,
+// or -1 if no matching character is present.
+func (m matcher[P]) LastIndexIn(str string) int {
+	if o, ok := any(m.p).(interface{ LastIndexIn(str string) int }); ok {
+		return o.LastIndexIn(str)
+	}
+	return lastIndexIn(m, str)
+}
+
+// RuneLastIndexIn returns the rune offset of the last matching character in a character sequence,
+// or -1 if no matching character is present.
+func (m matcher[P]) RuneLastIndexIn(str string) int {
+	if o, ok := any(m.p).(interface{ RuneLastIndexIn(str string) int }); ok {
+		return o.RuneLastIndexIn(str)
+	}
+	return runeLastIndexIn(m, str)
+}
+
+// LastIndexInRunes returns the index of the last matching character in a rune slice,
+// or -1 if no matching character is present.
+func (m matcher[P]) LastIndexInRunes(runes []rune) int {
+	if o, ok := any(m.p).(interface{ LastIndexInRunes(runes []rune) int }); ok {
+		return o.LastIndexInRunes(runes)
+	}
+	return lastIndexInRunes(m, runes)
+}
+
+// CountIn returns the number of matching characters found in a character sequence.
+func (m matcher[P]) CountIn(str string) int {
+	if o, ok := any(m.p).(interface{ CountIn(str string) int }); ok {
+		return o.CountIn(str)
+	}
+	return countIn(m, str)
+}
+
+// RemoveFrom returns a string containing all non-matching characters of a character sequence, in order.
+func (m matcher[P]) RemoveFrom(str string) string {
+	if o, ok := any(m.p).(interface{ RemoveFrom(str string) string }); ok {
+		return o.RemoveFrom(str)
+	}
+	return removeFrom(m, str)
+}
+
+// RetainFrom returns a string containing all matching characters of a character sequence, in order.
+func (m matcher[P]) RetainFrom(str string) string {
+	if o, ok := any(m.p).(interface{ RetainFrom(str string) string }); ok {
+		return o.RetainFrom(str)
+	}
+	return retainFrom(m, str)
+}
+
+// ReplaceFromRune returns a string copy of the input character sequence, with each matching character
+// replaced by a given replacement character.
+func (m matcher[P]) ReplaceFromRune(str string, replacement rune) string {
+	if o, ok := any(m.p).(interface {
+		ReplaceFromRune(str string, replacement rune) string
+	}); ok {
+		return o.ReplaceFromRune(str, replacement)
+	}
+	return replaceFromRune(m, str, replacement)
+}
+
+// ReplaceFrom returns a string copy of the input character sequence, with each matching character
+// replaced by a given replacement sequence.
+func (m matcher[P]) ReplaceFrom(str string, replacement string) string {
+	if o, ok := any(m.p).(interface {
+		ReplaceFrom(str string, replacement string) string
+	}); ok {
+		return o.ReplaceFrom(str, replacement)
+	}
+	return replaceFrom(m, str, replacement)
+}
+
+// TrimFrom returns a substring of the input character sequence that omits all matching characters
+// from the beginning and from the end of the string.
+func (m matcher[P]) TrimFrom(str string) string {
+	if o, ok := any(m.p).(interface{ TrimFrom(str string) string }); ok {
+		return o.TrimFrom(str)
+	}
+	return trimFrom(m, str)
+}
+
+// TrimLeadingFrom returns a substring of the input character sequence that omits all matching characters
+// from the beginning of the string.
+func (m matcher[P]) TrimLeadingFrom(str string) string {
+	if o, ok := any(m.p).(interface{ TrimLeadingFrom(str string) string }); ok {
+		return o.TrimLeadingFrom(str)
+	}
+	return trimLeadingFrom(m, str)
+}
+
+// TrimTrailingFrom returns a substring of the input character sequence that omits all matching characters
+// from the end of the string.
+func (m matcher[P]) TrimTrailingFrom(str string) string {
+	if o, ok := any(m.p).(interface{ TrimTrailingFrom(str string) string }); ok {
+		return o.TrimTrailingFrom(str)
+	}
+	return trimTrailingFrom(m, str)
+}
+
+// CollapseFrom returns a string copy of the input character sequence, with each group of consecutive matching
+// characters replaced by a single replacement character.
+func (m matcher[P]) CollapseFrom(str string, replacement rune) string {
+	if o, ok := any(m.p).(interface {
+		CollapseFrom(str string, replacement rune) string
+	}); ok {
+		return o.CollapseFrom(str, replacement)
+	}
+	return collapseFrom(m, str, replacement)
+}
+
+// TrimAndCollapseFrom collapses groups of matching characters exactly as CollapseFrom(str, replacement) does,
+// except that groups of matching characters at the start or end of the sequence are removed without replacement.
+func (m matcher[P]) TrimAndCollapseFrom(str string, replacement rune) string {
+	if o, ok := any(m.p).(interface {
+		TrimAndCollapseFrom(str string, replacement rune) string
+	}); ok {
+		return o.TrimAndCollapseFrom(str, replacement)
+	}
+	return trimAndCollapseFrom(m, str, replacement)
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	. "github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+type vowel struct{}
+
+func (vowel) Matches(r rune) bool {
+	return strings.ContainsRune("aeiouAEIOU", r)
+}
+
+func (vowel) String() string {
+	return "vowel"
+}
+
+type countingVowel struct {
+	vowel
+	calls *int
+}
+
+func (v countingVowel) CountIn(str string) int {
+	*v.calls++
+	return strings.Count(str, "e")
+}
+
+func (v countingVowel) Negate() Matcher {
+	return Is('!')
+}
+
+func TestFromPredicate(t *testing.T) {
+	m := FromPredicate(vowel{})
+	Equal(t, "vowel", m.String())
+	True(t, m.Matches('e'))
+	Equal(t, 5, m.CountIn("Guava library"))
+	Equal(t, "G-v- l-br-ry", m.CollapseFrom("Guava library", '-'))
+	Equal(t, "x", m.TrimAndCollapseFrom("aexoo", '-'))
+	Equal(t, "vowel.negate()", m.Negate().String())
+	Equal(t, m, m.Negate().Negate())
+	Equal(t, "AE", m.Precomputed().RetainFrom("xAyE"))
+	Equal(t, m, FromPredicate(m))
+
+	_, err := m.ToCharClass()
+	EqualError(t, err, "vowel cannot be represented as a character class")
+}
+
+func TestFromPredicate_Override(t *testing.T) {
+	calls := 0
+	m := FromPredicate(countingVowel{calls: &calls})
+	Equal(t, 2, m.CountIn("a tree"))
+	Equal(t, 1, calls)
+	Equal(t, 2, m.Negate().CountIn("yes!!"))
+	Equal(t, " tr", m.RemoveFrom("a tree"))
+}
+
+func TestFunc(t *testing.T) {
+	m := Func("Matcher.upper()", unicode.IsUpper)
+	Equal(t, "Matcher.upper()", m.String())
+	Equal(t, "GL", m.RetainFrom("Go Language"))
+	Equal(t, "Matcher.upper().negate()", m.Negate().String())
+	Equal(t, "oanguage", m.And(Is(' ').Negate()).Or(Is(' ')).Negate().Negate().RemoveFrom("Go Language"))
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+// bitSetMatcher matches characters in the Basic Multilingual Plane by means of a bit set and supplementary characters
+// by means of a sorted range table.
+type bitSetMatcher struct {
+	desc   string
+	bits   *[bmpWords]uint64
+	ranges []runeRange
+}
+
+// Matches determines a true or false value for the given character.
+func (m bitSetMatcher) Matches(r rune) bool {
+	if r < 0 {
+		return false
+	} else if r < minSupplementary {
+		return m.bits[r>>6]&(1<<(r&63)) != 0
+	}
+	return searchRanges(m.ranges, r)
+}
+
+// String returns a string representation of this Matcher.
+func (m bitSetMatcher) String() string {
+	return m.desc
+}
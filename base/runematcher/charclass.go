@@ -0,0 +1,189 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// FromCharClass returns a char matcher that matches any character matched by the given regular expression, which
+// must match exactly one character, e.g., a character class like "[a-zA-Z0-9_\p{Greek}]", "\d" or "\p{Lu}".
+// The syntax is the one accepted by the regexp package.
+func FromCharClass(class string) (Matcher, error) {
+	re, err := syntax.Parse(class, syntax.Perl)
+	if err != nil {
+		return nil, precond.CheckArgumentWrapf(false, err, "invalid character class %q: %v", class, err)
+	}
+
+	desc := "Matcher.fromCharClass(" + strconv.Quote(class) + ")"
+	var ranges []runeRange
+	switch {
+	case re.Op == syntax.OpCharClass:
+		for i := 0; i < len(re.Rune); i += 2 {
+			ranges = append(ranges, runeRange{re.Rune[i], re.Rune[i+1]})
+		}
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1 && re.Flags&syntax.FoldCase == 0:
+		ranges = append(ranges, runeRange{re.Rune[0], re.Rune[0]})
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1:
+		// case-insensitive literal, e.g., "(?i)k" or "[Aa]", which the parser simplifies to a literal
+		ranges = foldRanges(re.Rune[0])
+	case re.Op == syntax.OpAnyChar:
+		ranges = append(ranges, runeRange{0, unicode.MaxRune})
+	case re.Op == syntax.OpAnyCharNotNL:
+		ranges = append(ranges, runeRange{0, '\n' - 1}, runeRange{'\n' + 1, unicode.MaxRune})
+	case re.Op == syntax.OpNoMatch:
+	default:
+		return nil, precond.CheckArgumentf(false, "not a character class: %q", class)
+	}
+	return FromPredicate(newRangeTable(desc, ranges)), nil
+}
+
+// foldRanges returns the sorted ranges of all characters, which are equivalent to r under simple case folding.
+func foldRanges(r rune) []runeRange {
+	orbit := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	sort.Slice(orbit, func(i, j int) bool { return orbit[i] < orbit[j] })
+
+	ranges := make([]runeRange, 0, len(orbit))
+	for _, f := range orbit {
+		if n := len(ranges); n > 0 && ranges[n-1].hi+1 == f {
+			ranges[n-1].hi = f
+		} else {
+			ranges = append(ranges, runeRange{f, f})
+		}
+	}
+	return ranges
+}
+
+// toCharClass returns a regular expression character class, which matches the same characters as m.
+// Unicode range tables are referred to by their names, whereas other matchers are represented by the ranges of
+// characters they match.
+func toCharClass(m Matcher) (string, error) {
+	if err := precond.CheckArgumentf(!opaque(m), "%s cannot be represented as a character class", m); err != nil {
+		return "", err
+	}
+
+	items, negated := classItems(m)
+	if negated {
+		return "[^" + items + "]", nil
+	}
+	return "[" + items + "]", nil
+}
+
+// opaque returns true if the behavior of m is defined by an arbitrary predicate, e.g., a function or a Predicate
+// implemented outside of this package.
+func opaque(m Matcher) bool {
+	switch p := predicateOf(m).(type) {
+	case negatedMatcher:
+		return opaque(p.original)
+	case andMatcher:
+		return opaque(p.first) || opaque(p.second)
+	case orMatcher:
+		return opaque(p.first) || opaque(p.second)
+	case anyMatcher, noneMatcher, whitespaceMatcher, breakingWhitespaceMatcher, asciiMatcher, digitMatcher,
+		invisibleMatcher, singleWidthMatcher, inTableMatcher, isMatcher, isNotMatcher, isEitherMatcher, anyOfMatcher,
+		inRangeMatcher, smallSetMatcher, bitSetMatcher, rangeTableMatcher:
+		return false
+	default:
+		return true
+	}
+}
+
+// classItems returns the items of a (possibly negated) character class, which matches the same characters as m.
+func classItems(m Matcher) (items string, negated bool) {
+	switch p := predicateOf(m).(type) {
+	case inTableMatcher:
+		sb := strings.Builder{}
+		for _, t := range p.tables {
+			// the regexp package supports categories and scripts, but no other properties
+			name, ok := tableNames()[t]
+			if !ok || unicode.Categories[name] != t && unicode.Scripts[name] != t {
+				return rangeItems(rangesOf(m)), false
+			}
+			sb.WriteString(`\p{` + name + `}`)
+		}
+		return sb.String(), false
+	case negatedMatcher:
+		items, negated = classItems(p.original)
+		return items, !negated
+	case orMatcher:
+		first, negated1 := classItems(p.first)
+		second, negated2 := classItems(p.second)
+		if !negated1 && !negated2 {
+			return first + second, false
+		}
+	case rangeTableMatcher:
+		return shortestItems(p.ranges)
+	}
+	return shortestItems(rangesOf(m))
+}
+
+// shortestItems returns the items of either a character class, which matches the given ranges, or a negated one,
+// which matches the complementary ranges, whichever is shorter.
+func shortestItems(ranges []runeRange) (items string, negated bool) {
+	var complement []runeRange
+	lo := rune(0)
+	for _, rr := range ranges {
+		if rr.lo > lo {
+			complement = append(complement, runeRange{lo, rr.lo - 1})
+		}
+		lo = rr.hi + 1
+	}
+	if lo <= unicode.MaxRune {
+		complement = append(complement, runeRange{lo, unicode.MaxRune})
+	}
+
+	items, negItems := rangeItems(ranges), rangeItems(complement)
+	if len(ranges) == 0 || len(complement) > 0 && len(negItems) < len(items) {
+		return negItems, true
+	}
+	return items, false
+}
+
+// rangeItems returns the items of a character class, which matches the given ranges.
+func rangeItems(ranges []runeRange) string {
+	sb := strings.Builder{}
+	for _, rr := range ranges {
+		sb.WriteString(escapeRune(rr.lo))
+		if rr.hi > rr.lo {
+			if rr.hi > rr.lo+1 {
+				sb.WriteString("-")
+			}
+			sb.WriteString(escapeRune(rr.hi))
+		}
+	}
+	return sb.String()
+}
+
+// escapeRune returns the representation of r in a character class.
+func escapeRune(r rune) string {
+	switch {
+	case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+		return string(r)
+	case r < unicode.MaxASCII && (unicode.IsPunct(r) || unicode.IsSymbol(r)):
+		return `\` + string(r)
+	default:
+		return fmt.Sprintf(`\x{%X}`, r)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher_test
+
+import (
+	"regexp"
+	"testing"
+	"unicode"
+
+	"github.com/abc-inc/goava/base/precond"
+	. "github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestFromCharClass(t *testing.T) {
+	m, err := FromCharClass(`[a-zA-Z0-9_\p{Greek}]`)
+	NoError(t, err)
+	True(t, m.MatchesAllOf("azAZ09_αΩ"))
+	False(t, m.MatchesAnyOf(" -äЖ"))
+	Equal(t, `Matcher.fromCharClass("[a-zA-Z0-9_\\p{Greek}]")`, m.String())
+
+	tests := []struct {
+		class   string
+		match   string
+		noMatch string
+	}{
+		{`[^a-c]`, "d\n\U0001F600", "abc"},
+		{`\d`, "0123456789", "a٠"},
+		{`\p{Lu}`, "AÄΩ", "aä1"},
+		{`[[:space:]]`, " \t\n", "a "},
+		{`x`, "x", "y"},
+		{`.`, "a\U0001F600", "\n"},
+		{`(?s:.)`, "a\n", ""},
+		{`[^\x00-\x{10FFFF}]`, "", "a\n"},
+		{`[Aa]`, "Aa", "Bb\u00C4"},
+		{`[xX]`, "xX", "yY"},
+		{`(?i)a`, "aA", "bB"},
+		{`(?i)k`, "kK\u212A", "lL"},
+		{`(?i)\x{17F}`, "sS\u017F", "tT"},
+	}
+	for _, tc := range tests {
+		m, err := FromCharClass(tc.class)
+		NoError(t, err, tc.class)
+		True(t, m.MatchesAllOf(tc.match), tc.class)
+		True(t, m.MatchesNoneOf(tc.noMatch), tc.class)
+	}
+}
+
+func TestFromCharClass_Invalid(t *testing.T) {
+	_, err := FromCharClass(`[a-`)
+	ErrorIs(t, err, precond.ErrIllegalArgument)
+	ErrorContains(t, err, `invalid character class "[a-"`)
+
+	_, err = FromCharClass(`ab`)
+	EqualError(t, err, `not a character class: "ab"`)
+	_, err = FromCharClass(`(?i)ab`)
+	EqualError(t, err, `not a character class: "(?i)ab"`)
+}
+
+func TestToCharClass(t *testing.T) {
+	tests := []struct {
+		m     Matcher
+		class string
+	}{
+		{Is('a'), `[a]`},
+		{IsNot('a'), `[^a]`},
+		{AnyOf("a-]"), `[\-\]a]`},
+		{InRange('a', 'z'), `[a-z]`},
+		{InRange('a', 'b'), `[ab]`},
+		{Digit(), ``},
+		{Letter(), `[\p{L}]`},
+		{LetterOrDigit().Negate(), `[^\p{L}\p{Nd}]`},
+		{InCategory(unicode.Lu).Or(inScript(t, "Greek")), `[\p{Lu}\p{Greek}]`},
+		{None(), `[^\x{0}-\x{10FFFF}]`},
+		{Any(), `[\x{0}-\x{10FFFF}]`},
+		{ASCII().And(IsNot(' ')), `[\x{0}-\x{1F}\!-\x{7F}]`},
+	}
+
+	for _, tc := range tests {
+		class, err := tc.m.ToCharClass()
+		NoError(t, err)
+		if tc.class != "" {
+			Equal(t, tc.class, class, tc.m.String())
+		}
+		assertEquivalent(t, tc.m, class)
+	}
+}
+
+func TestToCharClass_Opaque(t *testing.T) {
+	_, err := ForPredicate(unicode.IsLetter).ToCharClass()
+	EqualError(t, err, "Matcher.forPredicate() cannot be represented as a character class")
+
+	_, err = Digit().Or(ForPredicate(unicode.IsLetter).Negate()).ToCharClass()
+	ErrorIs(t, err, precond.ErrIllegalArgument)
+
+	class, err := ForPredicate(unicode.IsLetter).Precomputed().ToCharClass()
+	NoError(t, err)
+	assertEquivalent(t, Letter(), class)
+}
+
+func TestCharClass_RoundTrip(t *testing.T) {
+	for _, m := range []Matcher{Whitespace(), BreakingWhitespace(), Invisible(), SingleWidth(), UpperCase(),
+		JavaIsoControl(), Whitespace().Or(Digit()).And(ASCII().Negate())} {
+		class, err := m.ToCharClass()
+		NoError(t, err)
+		assertEquivalent(t, m, class)
+	}
+}
+
+// assertEquivalent asserts that m matches the same characters as FromCharClass(class) and, for a sample of the
+// characters, as the regular expression.
+func assertEquivalent(t *testing.T, m Matcher, class string) {
+	re := regexp.MustCompile("^" + class + "$")
+	fromClass, err := FromCharClass(class)
+	NoError(t, err)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if unicode.Is(unicode.Cs, r) {
+			continue
+		}
+		exp := m.Matches(r)
+		if exp != fromClass.Matches(r) || (r < 0x3000 || r%31 == 0) && exp != re.MatchString(string(r)) {
+			Failf(t, "mismatch", "%s: %s: %U", m, class, r)
+			return
+		}
+	}
+}
+
+func inScript(t *testing.T, name string) Matcher {
+	m, err := InScript(name)
+	NoError(t, err)
+	return m
+}
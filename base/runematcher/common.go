@@ -21,15 +21,15 @@ import (
 )
 
 func negate(m Matcher) Matcher {
-	return negatedMatcher{m}
+	return FromPredicate(negatedMatcher{m})
 }
 
 func and(first, second Matcher) Matcher {
-	return andMatcher{first, second}
+	return FromPredicate(andMatcher{first, second})
 }
 
 func or(first, second Matcher) Matcher {
-	return orMatcher{first, second}
+	return FromPredicate(orMatcher{first, second})
 }
 
 func matchesAnyOf(m Matcher, str string) bool {
@@ -37,9 +37,8 @@ func matchesAnyOf(m Matcher, str string) bool {
 }
 
 func matchesAllOf(m Matcher, str string) bool {
-	runes := []rune(str)
-	for i := len(runes) - 1; i >= 0; i-- {
-		if !m.Matches(runes[i]) {
+	for _, r := range str {
+		if !m.Matches(r) {
 			return false
 		}
 	}
@@ -65,11 +64,53 @@ func indexInRunes(m Matcher, runes []rune, start int) int {
 }
 
 func indexIn(m Matcher, str string, start int) int {
-	return m.IndexInRunes([]rune(str), start)
+	if start < 0 || start >= len(str) {
+		return -1
+	}
+
+	for i, r := range str[start:] {
+		if m.Matches(r) {
+			return start + i
+		}
+	}
+	return -1
+}
+
+func runeIndexIn(m Matcher, str string, start int) int {
+	if start < 0 {
+		return -1
+	}
+
+	n := 0
+	for _, r := range str {
+		if n >= start && m.Matches(r) {
+			return n
+		}
+		n++
+	}
+	return -1
 }
 
 func lastIndexIn(m Matcher, str string) int {
-	runes := []rune(str)
+	for i := len(str); i > 0; {
+		r, size := utf8.DecodeLastRuneInString(str[:i])
+		i -= size
+		if m.Matches(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+func runeLastIndexIn(m Matcher, str string) int {
+	i := m.LastIndexIn(str)
+	if i == -1 {
+		return -1
+	}
+	return utf8.RuneCountInString(str[:i])
+}
+
+func lastIndexInRunes(m Matcher, runes []rune) int {
 	for i := len(runes) - 1; i >= 0; i-- {
 		if m.Matches(runes[i]) {
 			return i
@@ -89,27 +130,22 @@ func countIn(m Matcher, str string) int {
 }
 
 func removeFrom(m Matcher, str string) string {
-	runes := []rune(str)
-	pos := m.IndexInRunes(runes, 0)
+	pos := m.IndexIn(str, 0)
 	if pos == -1 {
 		return str
 	}
 
-	spread := 1
-	for {
-		pos++
-		for {
-			if pos == len(runes) {
-				return string(runes[0 : pos-spread])
-			}
-			if m.Matches(runes[pos]) {
-				break
-			}
-			runes[pos-spread] = runes[pos]
-			pos++
+	buf := strings.Builder{}
+	buf.Grow(len(str))
+	buf.WriteString(str[:pos])
+	for pos < len(str) {
+		r, size := utf8.DecodeRuneInString(str[pos:])
+		if !m.Matches(r) {
+			buf.WriteString(str[pos : pos+size])
 		}
-		spread++
+		pos += size
 	}
+	return buf.String()
 }
 
 func retainFrom(m Matcher, str string) string {
@@ -117,155 +153,99 @@ func retainFrom(m Matcher, str string) string {
 }
 
 func replaceFromRune(m Matcher, str string, replacement rune) string {
-	runes := []rune(str)
-
-	pos := m.IndexInRunes(runes, 0)
-	if pos == -1 {
-		return str
-	}
-
-	runes[pos] = replacement
-	for i := pos + 1; i < len(runes); i++ {
-		if m.Matches(runes[i]) {
-			runes[i] = replacement
-		}
-	}
-	return string(runes)
+	return replaceFrom(m, str, string(replacement))
 }
 
 func replaceFrom(m Matcher, str string, replacement string) string {
-	replacementLen := len(replacement)
-	if replacementLen == 0 {
+	if len(replacement) == 0 {
 		return m.RemoveFrom(str)
 	}
-	if replacementLen == 1 {
-		r, _ := utf8.DecodeRuneInString(replacement)
-		return m.ReplaceFromRune(str, r)
-	}
 
-	runes := []rune(str)
-	pos := m.IndexInRunes(runes, 0)
+	pos := m.IndexIn(str, 0)
 	if pos == -1 {
 		return str
 	}
 
 	buf := strings.Builder{}
 	buf.Grow((len(str) * 3 / 2) + 16)
-
-	oldpos := 0
-	for {
-		buf.WriteString(string(runes[oldpos:pos]))
-		buf.WriteString(replacement)
-		oldpos = pos + 1
-		pos = m.IndexInRunes(runes, oldpos)
-		if pos == -1 {
-			break
+	buf.WriteString(str[:pos])
+	for pos < len(str) {
+		r, size := utf8.DecodeRuneInString(str[pos:])
+		if m.Matches(r) {
+			buf.WriteString(replacement)
+		} else {
+			buf.WriteString(str[pos : pos+size])
 		}
+		pos += size
 	}
-
-	buf.WriteString(string(runes[oldpos:]))
 	return buf.String()
 }
 
 func trimFrom(m Matcher, str string) string {
-	runes := []rune(str)
-	length := len(runes)
-
-	var first int
-	var last int
-
-	for first = 0; first < length; first++ {
-		if !m.Matches(runes[first]) {
-			break
-		}
-	}
-	for last = length - 1; last > first; last-- {
-		if !m.Matches(runes[last]) {
-			break
-		}
-	}
-
-	return string(runes[first : last+1])
+	return trimTrailingFrom(m, trimLeadingFrom(m, str))
 }
 
 func trimLeadingFrom(m Matcher, str string) string {
-	runes := []rune(str)
-	length := len(runes)
-
-	for first := 0; first < length; first++ {
-		if !m.Matches(runes[first]) {
-			return string(runes[first:length])
+	for first, r := range str {
+		if !m.Matches(r) {
+			return str[first:]
 		}
 	}
 	return ""
 }
 
 func trimTrailingFrom(m Matcher, str string) string {
-	runes := []rune(str)
-	for last := len(runes) - 1; last >= 0; last-- {
-		if !m.Matches(runes[last]) {
-			return string(runes[0 : last+1])
+	for last := len(str); last > 0; {
+		r, size := utf8.DecodeLastRuneInString(str[:last])
+		if !m.Matches(r) {
+			return str[:last]
 		}
+		last -= size
 	}
 	return ""
 }
 
 func collapseFrom(m Matcher, str string, replacement rune) string {
-	runes := []rune(str)
-
-	length := len(runes)
-	for i := 0; i < length; i++ {
-		c := runes[i]
-		if m.Matches(c) {
-			if c == replacement && (i == length-1 || !m.Matches(runes[i+1])) {
-				// a no-op replacement
-				i++
-			} else {
+	repl := string(replacement)
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if m.Matches(r) {
+			next, _ := utf8.DecodeRuneInString(str[i+size:])
+			// unless it is a no-op replacement
+			if str[i:i+size] != repl || i+size < len(str) && m.Matches(next) {
 				builder := &strings.Builder{}
-				builder.Grow(length)
-				builder.WriteString(string(runes[0:i]))
-				builder.WriteRune(replacement)
-				return finishCollapseFrom(m, runes, i+1, length, replacement, builder, true)
+				builder.Grow(len(str))
+				builder.WriteString(str[:i])
+				builder.WriteString(repl)
+				return finishCollapseFrom(m, str[i+size:], repl, builder, true)
 			}
 		}
+		i += size
 	}
 	// no replacement needed
 	return str
 }
 
 func trimAndCollapseFrom(m Matcher, str string, replacement rune) string {
-	runes := []rune(str)
-
-	length := len(runes)
-	first := 0
-	last := length - 1
-
-	for first < length && m.Matches(runes[first]) {
-		first++
-	}
-
-	for last > first && m.Matches(runes[last]) {
-		last--
-	}
-
-	if first == 0 && last == length-1 {
+	trimmed := trimFrom(m, str)
+	if len(trimmed) == len(str) {
 		return collapseFrom(m, str, replacement)
 	}
 	builder := &strings.Builder{}
-	builder.Grow(last + 1 - first)
-	return finishCollapseFrom(m, runes, first, last+1, replacement, builder, false)
+	builder.Grow(len(trimmed))
+	return finishCollapseFrom(m, trimmed, string(replacement), builder, false)
 }
 
-func finishCollapseFrom(m Matcher, runes []rune, start, end int, repl rune, builder *strings.Builder, inMatchingGroup bool) string {
-	for i := start; i < end; i++ {
-		c := runes[i]
-		if m.Matches(c) {
+func finishCollapseFrom(m Matcher, str, repl string, builder *strings.Builder, inMatchingGroup bool) string {
+	for i, r := range str {
+		if m.Matches(r) {
 			if !inMatchingGroup {
-				builder.WriteRune(repl)
+				builder.WriteString(repl)
 				inMatchingGroup = true
 			}
 		} else {
-			builder.WriteRune(c)
+			_, size := utf8.DecodeRuneInString(str[i:])
+			builder.WriteString(str[i : i+size])
 			inMatchingGroup = false
 		}
 	}
@@ -16,6 +16,7 @@ package runematcher_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/abc-inc/goava/base/runematcher"
 )
@@ -79,3 +80,9 @@ func Example_whitespaceTrimFrom() {
 	fmt.Println(trimmed)
 	// Output: charming
 }
+
+func Example_funcCollapseFrom() {
+	vowel := runematcher.Func("vowel", func(r rune) bool { return strings.ContainsRune("aeiou", r) })
+	fmt.Println(vowel.CollapseFrom("bookkeeper", '*'))
+	// Output: b*kk*p*r
+}
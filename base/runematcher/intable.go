@@ -0,0 +1,92 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// inTableMatcher matches any character contained in at least one of the given Unicode range tables.
+type inTableMatcher struct {
+	desc   string
+	tables []*unicode.RangeTable
+}
+
+// Matches determines a true or false value for the given character.
+func (m inTableMatcher) Matches(r rune) bool {
+	for _, t := range m.tables {
+		if unicode.Is(t, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a string representation of this Matcher.
+func (m inTableMatcher) String() string {
+	return m.desc
+}
+
+// tableNames maps the range tables of the unicode package to their names.
+var tableNames = sync.OnceValue(func() map[*unicode.RangeTable]string {
+	names := map[*unicode.RangeTable]string{}
+	// categories take precedence over scripts and properties, e.g., "Lu" is preferred over "Upper"
+	for _, ts := range []map[string]*unicode.RangeTable{unicode.Properties, unicode.Scripts, unicode.Categories} {
+		keys := make([]string, 0, len(ts))
+		for k := range ts {
+			keys = append(keys, k)
+		}
+		// if a table has multiple names, the shortest one (or the lexicographically first one) wins
+		sort.Slice(keys, func(i, j int) bool {
+			return len(keys[i]) > len(keys[j]) || len(keys[i]) == len(keys[j]) && keys[i] > keys[j]
+		})
+		for _, k := range keys {
+			names[ts[k]] = k
+		}
+	}
+	return names
+})
+
+// tableName returns the name of the given range table or a description of its ranges.
+func tableName(t *unicode.RangeTable) string {
+	if name, ok := tableNames()[t]; ok {
+		return name
+	}
+
+	desc := strings.Builder{}
+	desc.WriteString("[")
+	for _, r := range t.R16 {
+		writeRange(&desc, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	for _, r := range t.R32 {
+		writeRange(&desc, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	desc.WriteString("]")
+	return desc.String()
+}
+
+func writeRange(desc *strings.Builder, lo, hi, stride rune) {
+	desc.WriteString(showCharacter(lo))
+	if lo != hi {
+		desc.WriteString("-" + showCharacter(hi))
+		if stride != 1 {
+			desc.WriteString("/" + strconv.Itoa(int(stride)))
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher_test
+
+import (
+	"testing"
+	"unicode"
+
+	. "github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestLetter(t *testing.T) {
+	m := Letter()
+	True(t, m.MatchesAllOf("aZäßΩЖ東"))
+	False(t, m.MatchesAnyOf("1 _-١"))
+	Equal(t, "Matcher.letter()", m.String())
+}
+
+func TestLetterOrDigit(t *testing.T) {
+	m := LetterOrDigit()
+	True(t, m.MatchesAllOf("aZ09ä١東"))
+	False(t, m.MatchesAnyOf(" _-Ⅻ"))
+	Equal(t, "Matcher.letterOrDigit()", m.String())
+}
+
+func TestUpperCase(t *testing.T) {
+	m := UpperCase()
+	True(t, m.MatchesAllOf("AZÄΩЖⅫⒶ"))
+	False(t, m.MatchesAnyOf("azäω1 ǅ"))
+	Equal(t, "Matcher.upperCase()", m.String())
+}
+
+func TestLowerCase(t *testing.T) {
+	m := LowerCase()
+	True(t, m.MatchesAllOf("azäßωжⅻⓐª"))
+	False(t, m.MatchesAnyOf("AZÄΩ1 ǅ"))
+	Equal(t, "Matcher.lowerCase()", m.String())
+}
+
+func TestJavaIsoControl(t *testing.T) {
+	m := JavaIsoControl()
+	for r := rune(0); r <= 0xFFFF; r++ {
+		Equal(t, r <= 0x1F || (r >= 0x7F && r <= 0x9F), m.Matches(r), "%U", r)
+	}
+	Equal(t, "Matcher.javaIsoControl()", m.String())
+}
+
+func TestInCategory(t *testing.T) {
+	m := InCategory(unicode.Lu, unicode.Nd)
+	True(t, m.MatchesAllOf("AZ09Ä١"))
+	False(t, m.MatchesAnyOf("az _"))
+	Equal(t, "Matcher.inCategory(Lu, Nd)", m.String())
+
+	Equal(t, "Matcher.inCategory(L, Greek, Dash)",
+		InCategory(unicode.Letter, unicode.Greek, unicode.Properties["Dash"]).String())
+	Equal(t, None(), InCategory())
+
+	custom := &unicode.RangeTable{
+		R16:         []unicode.Range16{{Lo: 'a', Hi: 'e', Stride: 2}, {Lo: 'x', Hi: 'x', Stride: 1}},
+		R32:         []unicode.Range32{{Lo: 0x1F600, Hi: 0x1F64F, Stride: 1}},
+		LatinOffset: 2,
+	}
+	m = InCategory(custom)
+	True(t, m.MatchesAllOf("acex😀"))
+	False(t, m.MatchesAnyOf("bdy"))
+	Equal(t, `Matcher.inCategory([\u0061-\u0065/2\u0078\u1F600-\u1F64F])`, m.String())
+}
+
+func TestInScript(t *testing.T) {
+	m, err := InScript("Greek")
+	NoError(t, err)
+	True(t, m.MatchesAllOf("αβγΩ"))
+	False(t, m.MatchesAnyOf("abcЖ"))
+	Equal(t, "Matcher.inScript(Greek)", m.String())
+
+	_, err = InScript("Klingon")
+	EqualError(t, err, `unknown script: "Klingon"`)
+}
+
+func TestInCategory_Precomputed(t *testing.T) {
+	m := LetterOrDigit().And(InCategory(unicode.Latin))
+	p := m.Precomputed()
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if m.Matches(r) != p.Matches(r) {
+			Failf(t, "mismatch", "%U", r)
+		}
+	}
+}
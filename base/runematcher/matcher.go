@@ -66,9 +66,12 @@ import (
 // Matcher determines a true or false value for any rune.
 // Also offers basic text processing methods based on this function.
 // Implementations are strongly encouraged to be side-effect-free and immutable.
+// Custom matchers are created by FromPredicate (or Func), which provides all text processing methods.
 //
 // Throughout the documentation of this type, the phrase "matching character" is used to mean
 // "any rune value r for which Matches(r) returns true".
+// Methods operating on strings report positions as byte offsets, unless their name starts with Rune.
+// Invalid UTF-8 sequences are treated as utf8.RuneError, which is one byte wide, like range loops over strings do.
 type Matcher interface {
 	fmt.Stringer
 
@@ -84,6 +87,16 @@ type Matcher interface {
 	// Or returns a matcher that matches any character matched by either this matcher or other.
 	Or(other Matcher) Matcher
 
+	// Precomputed returns a matcher functionally equivalent to this one, but which may be faster to query than the
+	// original; your mileage may vary. Precomputation takes time and is likely to be worthwhile only if the
+	// precomputed matcher is queried many thousands of times.
+	Precomputed() Matcher
+
+	// ToCharClass returns a regular expression character class in the syntax of the regexp package, which matches
+	// the same characters as this matcher, e.g., [a-z] or [\p{Lu}\p{Nd}].
+	// Matchers based on arbitrary predicates (see ForPredicate) cannot be represented as a character class.
+	ToCharClass() (string, error)
+
 	// MatchesAnyOf returns true if a character sequence contains at least one matching character.
 	//
 	// Equivalent to !MatchesNoneOf(sequence)
@@ -97,18 +110,32 @@ type Matcher interface {
 	// Equivalent to !MatchesAnyOf(sequence).
 	MatchesNoneOf(str string) bool
 
-	// IndexIn returns the index of the first matching character in a character sequence,
-	// starting from a given position, or -1 if no character matches after that position.
+	// IndexIn returns the byte offset of the first matching character in a character sequence,
+	// starting from a given byte offset, or -1 if no character matches after that position.
+	// Like strings.IndexFunc, it is meant to be used for slicing str.
 	IndexIn(str string, start int) int
 
-	// IndexInRunes returns the index of the first matching character in a character sequence,
-	// starting from a given position, or -1 if no character matches after that position.
+	// RuneIndexIn returns the rune offset of the first matching character in a character sequence,
+	// starting from a given rune offset, or -1 if no character matches after that position.
+	RuneIndexIn(str string, start int) int
+
+	// IndexInRunes returns the index of the first matching character in a rune slice,
+	// starting from a given index, or -1 if no character matches after that position.
 	IndexInRunes(runes []rune, start int) int
 
-	// LastIndexIn returns the index of the last matching character in a character sequence,
+	// LastIndexIn returns the byte offset of the last matching character in a character sequence,
 	// or -1 if no matching character is present.
+	// Like strings.LastIndexFunc, it is meant to be used for slicing str.
 	LastIndexIn(str string) int
 
+	// RuneLastIndexIn returns the rune offset of the last matching character in a character sequence,
+	// or -1 if no matching character is present.
+	RuneLastIndexIn(str string) int
+
+	// LastIndexInRunes returns the index of the last matching character in a rune slice,
+	// or -1 if no matching character is present.
+	LastIndexInRunes(runes []rune) int
+
 	// CountIn returns the number of matching characters found in a character sequence.
 	CountIn(str string) int
 
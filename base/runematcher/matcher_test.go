@@ -138,6 +138,9 @@ func reallyTestNoMatches(t *testing.T, m Matcher, s string) {
 	Equal(t, -1, m.IndexIn(s, -1))
 	Equal(t, -1, m.IndexInRunes([]rune(s), 0))
 	Equal(t, -1, m.LastIndexIn(s))
+	Equal(t, -1, m.RuneIndexIn(s, 0))
+	Equal(t, -1, m.RuneLastIndexIn(s))
+	Equal(t, -1, m.LastIndexInRunes([]rune(s)))
 	False(t, m.MatchesAnyOf(s))
 	False(t, m.MatchesAnyOf(s))
 	True(t, m.MatchesNoneOf(s))
@@ -154,7 +157,13 @@ func reallyTestAllMatches(t *testing.T, m Matcher, s string) {
 	Equal(t, 0, m.IndexIn(s, 0))
 	Equal(t, 1, m.IndexIn(s, 1))
 	Equal(t, -1, m.IndexIn(s, len(s)))
-	Equal(t, utf8.RuneCountInString(s)-1, m.LastIndexIn(s))
+	_, size := utf8.DecodeLastRuneInString(s)
+	Equal(t, len(s)-size, m.LastIndexIn(s))
+	Equal(t, 0, m.RuneIndexIn(s, 0))
+	Equal(t, 1, m.RuneIndexIn(s, 1))
+	Equal(t, -1, m.RuneIndexIn(s, utf8.RuneCountInString(s)))
+	Equal(t, utf8.RuneCountInString(s)-1, m.RuneLastIndexIn(s))
+	Equal(t, utf8.RuneCountInString(s)-1, m.LastIndexInRunes([]rune(s)))
 	True(t, m.MatchesAnyOf(s))
 	True(t, m.MatchesAnyOf(s))
 	False(t, m.MatchesNoneOf(s))
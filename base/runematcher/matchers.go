@@ -14,44 +14,50 @@
 
 package runematcher
 
-import "sort"
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/abc-inc/goava/base/precond"
+)
 
 // Any matches any character.
 func Any() Matcher {
-	return anyMatcher{}
+	return FromPredicate(anyMatcher{})
 }
 
 // None matches no characters.
 func None() Matcher {
-	return noneMatcher{}
+	return FromPredicate(noneMatcher{})
 }
 
 // Whitespace determines whether a character is whitespace according to the latest Unicode standard.
 func Whitespace() Matcher {
-	return whitespaceMatcher{}
+	return FromPredicate(whitespaceMatcher{})
 }
 
 // BreakingWhitespace determines whether a character is a breaking whitespace (that is, a whitespace which can be
 // interpreted as a break between words for formatting purposes).
 func BreakingWhitespace() Matcher {
-	return breakingWhitespaceMatcher{}
+	return FromPredicate(breakingWhitespaceMatcher{})
 }
 
 // ASCII determines whether a character is ASCII, meaning that its code point is less than 128.
 func ASCII() Matcher {
-	return asciiMatcher{}
+	return FromPredicate(asciiMatcher{})
 }
 
 // Digit determines whether a character is a digit according to Unicode.
 // If you only care to match ASCII digits, you can use InRange('0', '9').
 func Digit() Matcher {
-	return digitMatcher{}
+	return FromPredicate(digitMatcher{})
 }
 
 // Invisible determines whether a character is invisible; that is, if its Unicode category is any of
 // SPACE_SEPARATOR, LINE_SEPARATOR, PARAGRAPH_SEPARATOR, CONTROL, FORMAT, SURROGATE, and PRIVATE_USE.
 func Invisible() Matcher {
-	return invisibleMatcher{}
+	return FromPredicate(invisibleMatcher{})
 }
 
 // SingleWidth determines whether a character is single-width (not double-width).
@@ -59,19 +65,83 @@ func Invisible() Matcher {
 // When in doubt, this matcher errs on the side of returning false (that is, it tends to assume a character is
 // double-width).
 func SingleWidth() Matcher {
-	return singleWidthMatcher{}
+	return FromPredicate(singleWidthMatcher{})
+}
+
+// Letter determines whether a character is a letter according to Unicode (category L).
+func Letter() Matcher {
+	return FromPredicate(inTableMatcher{"Matcher.letter()", []*unicode.RangeTable{unicode.Letter}})
+}
+
+// LetterOrDigit determines whether a character is a letter or a digit according to Unicode (categories L and Nd).
+func LetterOrDigit() Matcher {
+	return FromPredicate(inTableMatcher{
+		"Matcher.letterOrDigit()", []*unicode.RangeTable{unicode.Letter, unicode.Digit}})
+}
+
+// UpperCase determines whether a character is upper case according to Unicode (category Lu and the property
+// Other_Uppercase).
+func UpperCase() Matcher {
+	return FromPredicate(inTableMatcher{
+		"Matcher.upperCase()", []*unicode.RangeTable{unicode.Upper, unicode.Other_Uppercase}})
+}
+
+// LowerCase determines whether a character is lower case according to Unicode (category Ll and the property
+// Other_Lowercase).
+func LowerCase() Matcher {
+	return FromPredicate(inTableMatcher{
+		"Matcher.lowerCase()", []*unicode.RangeTable{unicode.Lower, unicode.Other_Lowercase}})
+}
+
+// JavaIsoControl determines whether a character is an ISO control character as specified by
+// Character.isISOControl(char) in Java, i.e., if it is in the range '\u0000' through '\u001F' or in the range
+// '\u007F' through '\u009F' (which is exactly the Unicode category Cc).
+func JavaIsoControl() Matcher {
+	return FromPredicate(inTableMatcher{"Matcher.javaIsoControl()", []*unicode.RangeTable{unicode.Cc}})
+}
+
+// InCategory returns a char matcher that matches any character contained in at least one of the given range tables,
+// e.g., InCategory(unicode.Lu, unicode.Nd) or InCategory(unicode.Properties["Dash"]).
+//
+// The tables are referred to by their names in unicode.Categories, unicode.Scripts or unicode.Properties, if any;
+// otherwise, by their ranges.
+func InCategory(tables ...*unicode.RangeTable) Matcher {
+	if len(tables) == 0 {
+		return None()
+	}
+
+	desc := strings.Builder{}
+	desc.WriteString("Matcher.inCategory(")
+	for i, t := range tables {
+		if i > 0 {
+			desc.WriteString(", ")
+		}
+		desc.WriteString(tableName(t))
+	}
+	desc.WriteString(")")
+	return FromPredicate(inTableMatcher{desc.String(), append([]*unicode.RangeTable(nil), tables...)})
+}
+
+// InScript returns a char matcher that matches any character of the Unicode script with the given name, as defined
+// in unicode.Scripts, e.g., "Latin" or "Han".
+func InScript(name string) (Matcher, error) {
+	t, ok := unicode.Scripts[name]
+	if err := precond.CheckArgumentf(ok, "unknown script: %q", name); err != nil {
+		return nil, err
+	}
+	return FromPredicate(inTableMatcher{"Matcher.inScript(" + name + ")", []*unicode.RangeTable{t}}), nil
 }
 
 // Is returns a char matcher that matches only one specified character.
 func Is(r rune) Matcher {
-	return isMatcher{r}
+	return FromPredicate(isMatcher{r})
 }
 
 // IsNot returns a char matcher that matches any character except the character specified.
 //
 // To negate another Matcher, use Negate().
 func IsNot(r rune) Matcher {
-	return isNotMatcher{r}
+	return FromPredicate(isNotMatcher{r})
 }
 
 // AnyOf returns a char matcher that matches any character present in the given character sequence.
@@ -84,10 +154,10 @@ func AnyOf(match string) Matcher {
 	case 1:
 		return Is(runes[0])
 	case 2:
-		return isEitherMatcher{runes[0], runes[1]}
+		return FromPredicate(isEitherMatcher{runes[0], runes[1]})
 	default:
 		sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
-		return anyOfMatcher{string(runes)}
+		return FromPredicate(anyOfMatcher{string(runes)})
 	}
 }
 
@@ -100,10 +170,10 @@ func NoneOf(str string) Matcher {
 //
 // For example, to match any lowercase letter of the English alphabet, use InRange('a', 'z').
 func InRange(startIncl, endIncl rune) Matcher {
-	return inRangeMatcher{startIncl, endIncl}
+	return FromPredicate(inRangeMatcher{startIncl, endIncl})
 }
 
 // ForPredicate returns a matcher with identical behavior to the given character-based predicate.
 func ForPredicate(p func(rune) bool) Matcher {
-	return forPredicateMatcher{p}
+	return Func("Matcher.forPredicate()", p)
 }
@@ -15,8 +15,9 @@
 package runematcher_test
 
 import (
-	"reflect"
+	"strings"
 	"testing"
+	"unicode"
 
 	. "github.com/abc-inc/goava/base/runematcher"
 	. "github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ func TestAll(t *testing.T) {
 	ms := []Matcher{
 		Any(), None(), Whitespace(), BreakingWhitespace(), ASCII(), Digit(),
 		Invisible(), SingleWidth(), Is('^'), IsNot('^'),
+		InCategory(unicode.Ll), JavaIsoControl().Negate(),
 		AnyOf(""), AnyOf("."), AnyOf("01"), AnyOf("abc"),
 		NoneOf(" "), InRange('a', 'z'), ForPredicate(func(rune) bool { return true }),
 		Whitespace().And(BreakingWhitespace()),
@@ -33,12 +35,12 @@ func TestAll(t *testing.T) {
 		Whitespace().Negate(),
 	}
 	for _, m := range ms {
-		t.Run(reflect.TypeOf(m).Name(), func(t *testing.T) {
+		t.Run(m.String(), func(t *testing.T) {
 			False(t, m.And(None()).Matches('^'))
 			Equal(t, expVal(m, true, false), m.And(Any()).Matches('X'))
 			Equal(t, expVal(m, true, false), m.Or(None()).Matches('X'))
 			Equal(t, true, m.Or(Any()).Matches('X'))
-			if reflect.TypeOf(m).Name() != "forPredicateMatcher" {
+			if !strings.HasPrefix(m.String(), "Matcher.forPredicate()") {
 				Equal(t, m, m.Negate().Negate())
 			}
 
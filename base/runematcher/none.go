@@ -14,8 +14,6 @@
 
 package runematcher
 
-//go:generate go run gen.go none
-
 // noneMatcher matches no characters.
 type noneMatcher struct {
 }
@@ -25,24 +23,42 @@ func (m noneMatcher) Matches(r rune) bool {
 	return false
 }
 
-// IndexInRunes returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
-func (m noneMatcher) IndexInRunes(runes []rune, start int) int {
+// IndexIn returns the byte offset of the first matching character in a character sequence,
+// starting from a given byte offset, or -1 if no character matches after that position.
+func (m noneMatcher) IndexIn(str string, start int) int {
 	return -1
 }
 
-// IndexIn returns the index of the first matching character in a character sequence,
-// starting from a given position, or -1 if no character matches after that position.
-func (m noneMatcher) IndexIn(str string, start int) int {
+// RuneIndexIn returns the rune offset of the first matching character in a character sequence,
+// starting from a given rune offset, or -1 if no character matches after that position.
+func (m noneMatcher) RuneIndexIn(str string, start int) int {
+	return -1
+}
+
+// IndexInRunes returns the index of the first matching character in a rune slice,
+// starting from a given index, or -1 if no character matches after that position.
+func (m noneMatcher) IndexInRunes(runes []rune, start int) int {
 	return -1
 }
 
-// LastIndexIn returns the index of the last matching character in a character sequence,
+// LastIndexIn returns the byte offset of the last matching character in a character sequence,
 // or -1 if no matching character is present.
 func (m noneMatcher) LastIndexIn(str string) int {
 	return -1
 }
 
+// RuneLastIndexIn returns the rune offset of the last matching character in a character sequence,
+// or -1 if no matching character is present.
+func (m noneMatcher) RuneLastIndexIn(str string) int {
+	return -1
+}
+
+// LastIndexInRunes returns the index of the last matching character in a rune slice,
+// or -1 if no matching character is present.
+func (m noneMatcher) LastIndexInRunes(runes []rune) int {
+	return -1
+}
+
 // MatchesAllOf returns true if a character sequence contains only matching characters.
 func (m noneMatcher) MatchesAllOf(str string) bool {
 	return len(str) == 0
@@ -91,7 +107,7 @@ func (m noneMatcher) CountIn(str string) int {
 
 // And returns a matcher that matches any character matched by both this matcher and other.
 func (m noneMatcher) And(other Matcher) Matcher {
-	return m
+	return None()
 }
 
 // Or returns a matcher that matches any character matched by either this matcher or other.
@@ -108,35 +124,3 @@ func (m noneMatcher) Negate() Matcher {
 func (m noneMatcher) String() string {
 	return "Matcher.none()"
 }
-
-// Code generated. DO NOT EDIT.
-
-// MatchesAnyOf returns true if a character sequence contains at least one matching character.
-//
-// Equivalent to !MatchesNoneOf(sequence)
-func (m noneMatcher) MatchesAnyOf(str string) bool {
-	return matchesAnyOf(m, str)
-}
-
-// RetainFrom returns a string containing all matching characters of a character sequence, in order.
-func (m noneMatcher) RetainFrom(str string) string {
-	return retainFrom(m, str)
-}
-
-// TrimLeadingFrom returns a substring of the input character sequence that omits all matching characters
-// from the beginning of the string.
-func (m noneMatcher) TrimLeadingFrom(str string) string {
-	return trimLeadingFrom(m, str)
-}
-
-// TrimTrailingFrom returns a substring of the input character sequence that omits all matching characters
-// from the end of the string.
-func (m noneMatcher) TrimTrailingFrom(str string) string {
-	return trimTrailingFrom(m, str)
-}
-
-// TrimAndCollapseFrom collapses groups of matching characters exactly as CollapseFrom(str, replacement) does,
-// except that groups of matching characters at the start or end of the sequence are removed without replacement.
-func (m noneMatcher) TrimAndCollapseFrom(str string, replacement rune) string {
-	return trimAndCollapseFrom(m, str, replacement)
-}
@@ -0,0 +1,156 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+import (
+	"sort"
+	"unicode"
+)
+
+// minSupplementary is the smallest code point outside the Basic Multilingual Plane.
+const minSupplementary = 0x10000
+
+// bmpWords is the number of words needed for a bit set of all characters in the Basic Multilingual Plane.
+const bmpWords = minSupplementary / 64
+
+// maxSmallSet is the maximum number of characters stored in a smallSetMatcher, which is as large as a BMP bit set.
+const maxSmallSet = bmpWords * 2
+
+// latin1Words is the number of words needed for a bit set of all Latin-1 characters, which are looked up directly
+// by every table-driven matcher.
+const latin1Words = (unicode.MaxLatin1 + 1) / 64
+
+// runeRange is a range of characters (both endpoints are inclusive).
+type runeRange struct {
+	lo, hi rune
+}
+
+// matchesLatin1 returns true if r is a Latin-1 character contained in the given bit set.
+func matchesLatin1(latin1 *[latin1Words]uint64, r rune) (matches, ok bool) {
+	if r < 0 || r > unicode.MaxLatin1 {
+		return false, false
+	}
+	return latin1[r>>6]&(1<<(r&63)) != 0, true
+}
+
+// searchRanges returns true if r is contained in one of the given sorted, non-overlapping ranges.
+func searchRanges(ranges []runeRange, r rune) bool {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= r })
+	return i < len(ranges) && ranges[i].lo <= r
+}
+
+// precomputed returns a matcher functionally equivalent to m, which matches all valid code points by means of the
+// smallest of the following representations:
+//
+//   - a single character or a single range of characters
+//   - a sorted set of (matching or non-matching) characters
+//   - a bit set for the Basic Multilingual Plane plus a sorted range table for the supplementary planes
+//   - a sorted range table
+//
+// Matchers, which are already cheap to query, are returned as they are.
+func precomputed(m Matcher) Matcher {
+	switch predicateOf(m).(type) {
+	case anyMatcher, noneMatcher, asciiMatcher, isMatcher, isNotMatcher, isEitherMatcher, inRangeMatcher,
+		smallSetMatcher, bitSetMatcher, rangeTableMatcher:
+		return m
+	}
+
+	ranges := rangesOf(m)
+	count := 0
+	var bits [bmpWords]uint64
+	var set, unset []rune
+	prev := rune(-1)
+	for _, rr := range ranges {
+		count += int(rr.hi - rr.lo + 1)
+		for r := rr.lo; r <= rr.hi && r < minSupplementary; r++ {
+			bits[r>>6] |= 1 << (r & 63)
+		}
+		set = appendRange(set, rr.lo, rr.hi)
+		unset = appendRange(unset, prev+1, rr.lo-1)
+		prev = rr.hi
+	}
+	unset = appendRange(unset, prev+1, unicode.MaxRune)
+
+	desc := m.String() + ".precomputed()"
+	latin1 := [latin1Words]uint64(bits[:latin1Words])
+	switch {
+	case count == 0:
+		return None()
+	case count == unicode.MaxRune+1:
+		return Any()
+	case count == 1:
+		return Is(set[0])
+	case count == unicode.MaxRune:
+		return IsNot(unset[0])
+	case count == 2:
+		return FromPredicate(isEitherMatcher{set[0], set[1]})
+	}
+
+	// compare the sizes of the representations in units of 32 bits
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= minSupplementary })
+	supp := append([]runeRange(nil), ranges[i:]...)
+	if len(supp) > 0 {
+		supp[0].lo = max(supp[0].lo, minSupplementary)
+	}
+	sizeRanges := 2 * len(ranges)
+	sizeBits := 2*bmpWords + 2*len(supp)
+	if len(set) <= maxSmallSet && len(set) <= len(unset) && len(set) <= sizeRanges {
+		return FromPredicate(smallSetMatcher{desc, latin1, set, false})
+	} else if len(unset) <= maxSmallSet && len(unset) <= sizeRanges {
+		return FromPredicate(smallSetMatcher{desc, latin1, unset, true})
+	} else if sizeRanges <= sizeBits {
+		return FromPredicate(rangeTableMatcher{desc, latin1, ranges})
+	}
+	return FromPredicate(bitSetMatcher{desc, &bits, supp})
+}
+
+// rangesOf returns the sorted, non-overlapping ranges of all valid code points matched by m.
+func rangesOf(m Predicate) []runeRange {
+	var ranges []runeRange
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !m.Matches(r) {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].hi == r-1 {
+			ranges[n-1].hi = r
+		} else {
+			ranges = append(ranges, runeRange{r, r})
+		}
+	}
+	return ranges
+}
+
+// appendRange appends the characters from lo to hi (both inclusive) to rs, unless the result would exceed the
+// capacity of a smallSetMatcher.
+func appendRange(rs []rune, lo, hi rune) []rune {
+	if len(rs)+int(hi-lo+1) > maxSmallSet+1 {
+		hi = lo + rune(maxSmallSet-len(rs))
+	}
+	for r := lo; r <= hi; r++ {
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// newRangeTable returns a rangeTableMatcher for the given sorted, non-overlapping ranges.
+func newRangeTable(desc string, ranges []runeRange) rangeTableMatcher {
+	m := rangeTableMatcher{desc: desc, ranges: ranges}
+	for _, rr := range ranges {
+		for r := max(rr.lo, 0); r <= rr.hi && r <= unicode.MaxLatin1; r++ {
+			m.latin1[r>>6] |= 1 << (r & 63)
+		}
+	}
+	return m
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher_test
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+
+	. "github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestPrecomputed(t *testing.T) {
+	tests := []struct {
+		m   Matcher
+		typ string
+	}{
+		{Whitespace().Or(Digit()).And(ASCII().Negate()), "rangeTableMatcher"},
+		{Whitespace().Negate(), "rangeTableMatcher"},
+		{ForPredicate(unicode.IsUpper).And(InRange(0x100, 0x17F)), "smallSetMatcher"},
+		{ForPredicate(func(r rune) bool { return r < 0x100 && r%2 == 0 }).Negate(), "smallSetMatcher"},
+		{Invisible().Or(ForPredicate(unicode.IsLetter)), "rangeTableMatcher"},
+		{ForPredicate(func(r rune) bool { return r < 0x3000 && r%3 == 0 }).Or(Is(0x1F600)), "bitSetMatcher"},
+		{ForPredicate(func(r rune) bool { return r >= 0x1F600 && r%2 == 0 }), "rangeTableMatcher"},
+		{InRange('a', 'z').Or(InRange('A', 'Z')), "rangeTableMatcher"},
+		{AnyOf("aeiou").Or(AnyOf("AEIOU")), "smallSetMatcher"},
+		{InRange(0x100, 0x10FFFF).Or(InRange(0x10, 0x20)), "rangeTableMatcher"},
+		{Is('a').Or(Is('b')), "isEitherMatcher"},
+		{Is('a').And(IsNot('a')), "noneMatcher"},
+		{Is('a').Or(IsNot('a')), "anyMatcher"},
+		{ForPredicate(func(r rune) bool { return r == 'x' }), "isMatcher"},
+		{ForPredicate(func(r rune) bool { return r != 'x' }), "isNotMatcher"},
+	}
+
+	for _, tc := range tests {
+		p := tc.m.Precomputed()
+		Equal(t, "matcher[github.com/abc-inc/goava/base/runematcher."+tc.typ+"]", reflect.TypeOf(p).Name(), tc.m.String())
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if tc.m.Matches(r) != p.Matches(r) {
+				Failf(t, "mismatch", "%s: %U", p, r)
+			}
+		}
+	}
+}
+
+func TestPrecomputed_Fast(t *testing.T) {
+	for _, m := range []Matcher{Any(), None(), ASCII(), Is('a'), IsNot('a'), AnyOf("ab"), InRange('a', 'z')} {
+		Equal(t, m, m.Precomputed())
+	}
+
+	p := Whitespace().Negate().Precomputed()
+	Equal(t, p, p.Precomputed())
+	Equal(t, "Matcher.whitespace().negate().precomputed()", p.String())
+}
+
+func TestPrecomputed_Methods(t *testing.T) {
+	p := Whitespace().Or(Is('-')).Precomputed()
+	Equal(t, "a-b-c", p.CollapseFrom("a   b-  c", '-'))
+	Equal(t, "abc", p.RemoveFrom(" a-b\tc "))
+	Equal(t, 2, p.IndexIn("ab c", 0))
+	Equal(t, 3, p.CountIn("- a b"))
+	Equal(t, "x", p.TrimFrom(" -x- "))
+	True(t, p.Negate().Matches('a'))
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+// rangeTableMatcher matches any character in a sorted table of non-overlapping ranges.
+// Latin-1 characters are looked up in a bit set.
+type rangeTableMatcher struct {
+	desc   string
+	latin1 [latin1Words]uint64
+	ranges []runeRange
+}
+
+// Matches determines a true or false value for the given character.
+func (m rangeTableMatcher) Matches(r rune) bool {
+	if matches, ok := matchesLatin1(&m.latin1, r); ok {
+		return matches
+	}
+	return searchRanges(m.ranges, r)
+}
+
+// String returns a string representation of this Matcher.
+func (m rangeTableMatcher) String() string {
+	return m.desc
+}
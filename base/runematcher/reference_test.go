@@ -0,0 +1,183 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+	"unicode"
+	"unicode/utf8"
+
+	. "github.com/abc-inc/goava/base/runematcher"
+	. "github.com/stretchr/testify/require"
+)
+
+// pool contains characters of different UTF-8 lengths and edge cases, which are likely to reveal index handling bugs.
+var pool = []rune("aZ _-0\t\u00e9\u00df\u03a3\u03c2\u01c5\u00a0\u0301\u3000\u4e2d\ufffd\U0001f600\U00010428")
+
+// randomUTF8 returns a random, valid UTF-8 string of up to 20 characters.
+func randomUTF8(rnd *rand.Rand) string {
+	sb := strings.Builder{}
+	for n := rnd.Intn(21); n > 0; n-- {
+		r := pool[rnd.Intn(len(pool))]
+		if rnd.Intn(4) == 0 {
+			r = rune(rnd.Intn(unicode.MaxRune + 1))
+		}
+		if !utf8.ValidRune(r) {
+			r = utf8.RuneError
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// checkProperty verifies that prop holds for random UTF-8 strings and random starting positions.
+func checkProperty(t *testing.T, prop func(s string, start int) bool) {
+	cfg := &quick.Config{
+		MaxCount: 300,
+		Rand:     rand.New(rand.NewSource(42)),
+		Values: func(vs []reflect.Value, rnd *rand.Rand) {
+			vs[0] = reflect.ValueOf(randomUTF8(rnd))
+			vs[1] = reflect.ValueOf(rnd.Intn(24) - 2)
+		},
+	}
+	NoError(t, quick.Check(prop, cfg))
+}
+
+func TestReference(t *testing.T) {
+	ms := []Matcher{
+		Any(), None(), Whitespace(), BreakingWhitespace(), Digit(), Letter(), UpperCase(), Invisible(), SingleWidth(),
+		Is('\u00e9'), IsNot('\U0001f600'), AnyOf("a\u00e9\U0001f600\ufffd"), NoneOf("_\u4e2d"), InRange(0x80, 0x7FF),
+		Letter().Negate(), Digit().Or(Is('\U0001f600')), Whitespace().And(ASCII().Negate()),
+		ForPredicate(func(r rune) bool { return r%2 == 0 }), Whitespace().Or(Is('\U00010428')).Precomputed(),
+	}
+
+	for _, m := range ms {
+		t.Run(m.String(), func(t *testing.T) {
+			checkProperty(t, func(s string, start int) bool {
+				runes := []rune(s)
+				return m.IndexIn(s, byteOffset(s, start)) == refIndexIn(m, s, byteOffset(s, start)) &&
+					m.RuneIndexIn(s, start) == refIndexInRunes(m, runes, start) &&
+					m.IndexInRunes(runes, start) == refIndexInRunes(m, runes, start) &&
+					m.LastIndexIn(s) == strings.LastIndexFunc(s, m.Matches) &&
+					m.RuneLastIndexIn(s) == refLastIndexInRunes(m, runes) &&
+					m.LastIndexInRunes(runes) == refLastIndexInRunes(m, runes) &&
+					m.MatchesAnyOf(s) == strings.ContainsFunc(s, m.Matches) &&
+					m.MatchesAllOf(s) == !strings.ContainsFunc(s, m.Negate().Matches) &&
+					m.MatchesNoneOf(s) == !strings.ContainsFunc(s, m.Matches) &&
+					m.CountIn(s) == len(refReplace(m, runes, nil)) &&
+					m.RemoveFrom(s) == strings.Map(refMapping(m, -1, false), s) &&
+					m.RetainFrom(s) == strings.Map(refMapping(m, -1, true), s) &&
+					m.ReplaceFromRune(s, '\u2022') == strings.Map(refMapping(m, '\u2022', false), s) &&
+					m.ReplaceFrom(s, "<\u00e4>") == string(refReplace(m, runes, []rune("<\u00e4>"))) &&
+					m.TrimFrom(s) == strings.TrimFunc(s, m.Matches) &&
+					m.TrimLeadingFrom(s) == strings.TrimLeftFunc(s, m.Matches) &&
+					m.TrimTrailingFrom(s) == strings.TrimRightFunc(s, m.Matches) &&
+					m.CollapseFrom(s, '\u2022') == refCollapse(m, runes, '\u2022') &&
+					m.CollapseFrom(s, ' ') == refCollapse(m, runes, ' ') &&
+					m.TrimAndCollapseFrom(s, '\u2022') == refCollapse(m, []rune(strings.TrimFunc(s, m.Matches)), '\u2022')
+			})
+		})
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	s := "a\xffb \xe2\x82"
+	Equal(t, 1, Is(utf8.RuneError).IndexIn(s, 0))
+	Equal(t, 5, Is(utf8.RuneError).LastIndexIn(s))
+	Equal(t, 5, Is(utf8.RuneError).RuneLastIndexIn(s))
+	Equal(t, 3, Is(utf8.RuneError).CountIn(s))
+	Equal(t, "\xffb \xe2\x82", Is('a').RemoveFrom(s))
+	Equal(t, "a\xffb_\xe2\x82", Whitespace().ReplaceFromRune(s, '_'))
+	Equal(t, s, Whitespace().TrimFrom(" "+s+"  "))
+	Equal(t, "a\ufffdb \ufffd", Is(utf8.RuneError).CollapseFrom(s, utf8.RuneError))
+}
+
+// byteOffset converts a rune offset into a byte offset, retaining positions outside of s.
+func byteOffset(s string, runeOffset int) int {
+	if runeOffset < 0 || runeOffset >= utf8.RuneCountInString(s) {
+		return runeOffset + len(s) - utf8.RuneCountInString(s)
+	}
+	return len(string([]rune(s)[:runeOffset]))
+}
+
+func refIndexIn(m Matcher, s string, start int) int {
+	if start < 0 || start > len(s) {
+		return -1
+	}
+	if i := strings.IndexFunc(s[start:], m.Matches); i >= 0 {
+		return start + i
+	}
+	return -1
+}
+
+func refIndexInRunes(m Matcher, runes []rune, start int) int {
+	for i := max(start, 0); start >= 0 && i < len(runes); i++ {
+		if m.Matches(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func refLastIndexInRunes(m Matcher, runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if m.Matches(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func refMapping(m Matcher, replacement rune, retain bool) func(rune) rune {
+	return func(r rune) rune {
+		if m.Matches(r) == retain {
+			return r
+		}
+		return replacement
+	}
+}
+
+// refReplace replaces every matching character by replacement and returns the characters written for them.
+// If replacement is nil, it returns the matching characters instead.
+func refReplace(m Matcher, runes []rune, replacement []rune) []rune {
+	var out []rune
+	for _, r := range runes {
+		switch {
+		case m.Matches(r) && replacement == nil:
+			out = append(out, r)
+		case m.Matches(r):
+			out = append(out, replacement...)
+		case replacement != nil:
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func refCollapse(m Matcher, runes []rune, replacement rune) string {
+	var out []rune
+	for i, r := range runes {
+		if !m.Matches(r) {
+			out = append(out, r)
+		} else if i == 0 || !m.Matches(runes[i-1]) {
+			out = append(out, replacement)
+		}
+	}
+	return string(out)
+}
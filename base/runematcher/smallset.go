@@ -0,0 +1,40 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+import "sort"
+
+// smallSetMatcher matches any character present in (or absent from) a small, sorted set of characters.
+// Latin-1 characters are looked up in a bit set.
+type smallSetMatcher struct {
+	desc    string
+	latin1  [latin1Words]uint64
+	runes   []rune
+	negated bool
+}
+
+// Matches determines a true or false value for the given character.
+func (m smallSetMatcher) Matches(r rune) bool {
+	if matches, ok := matchesLatin1(&m.latin1, r); ok {
+		return matches
+	}
+	i := sort.Search(len(m.runes), func(i int) bool { return m.runes[i] >= r })
+	return (i < len(m.runes) && m.runes[i] == r) != m.negated
+}
+
+// String returns a string representation of this Matcher.
+func (m smallSetMatcher) String() string {
+	return m.desc
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitter_test
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/base/runematcher"
+	"github.com/abc-inc/goava/base/splitter"
+)
+
+func Example() {
+	s := splitter.OnRune(',').TrimResults(runematcher.Whitespace()).OmitEmptyStrings()
+	fmt.Printf("%q\n", s.SplitToSlice("foo,bar,,   qux"))
+
+	for it := s.Split(" a, b "); it.HasNext(); {
+		v, _ := it.Next()
+		fmt.Println(v)
+	}
+	// Output:
+	// ["foo" "bar" "qux"]
+	// a
+	// b
+}
+
+func ExampleOnRune() {
+	fmt.Printf("%q\n", splitter.OnRune(',').SplitToSlice(" foo,,, bar ,"))
+	// Output: [" foo" "" "" " bar " ""]
+}
+
+func ExampleSplitter_WithKeyValueSeparator() {
+	ms, _ := splitter.OnRune('&').WithKeyValueSeparator("=")
+	m, err := ms.Split("user=jane&lang=en")
+	fmt.Println(m, err)
+
+	_, err = ms.Split("user=jane&lang")
+	fmt.Println(err)
+	// Output:
+	// map[lang:en user:jane] <nil>
+	// chunk [lang] is not a valid entry
+}
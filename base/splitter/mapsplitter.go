@@ -0,0 +1,107 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitter
+
+import (
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// MapSplitter splits strings into key-value pairs, which are separated by an entry splitter and a key-value splitter.
+type MapSplitter struct {
+	outer Splitter
+	entry Splitter
+}
+
+// WithKeyValueSeparator returns a MapSplitter, which splits entries based on this splitter, and splits entries into
+// keys and values using the specified separator.
+//
+// An error is returned if sep is empty.
+func (s Splitter) WithKeyValueSeparator(sep string) (MapSplitter, error) {
+	kv, err := On(sep)
+	if err != nil {
+		return MapSplitter{}, err
+	}
+	return s.WithKeyValueSplitter(kv), nil
+}
+
+// WithKeyValueSeparatorRune returns a MapSplitter, which splits entries based on this splitter, and splits entries
+// into keys and values using the specified separator.
+func (s Splitter) WithKeyValueSeparatorRune(sep rune) MapSplitter {
+	return s.WithKeyValueSplitter(OnRune(sep))
+}
+
+// WithKeyValueSplitter returns a MapSplitter, which splits entries based on this splitter, and splits entries into
+// keys and values using the specified key-value splitter.
+func (s Splitter) WithKeyValueSplitter(kv Splitter) MapSplitter {
+	return MapSplitter{s, kv}
+}
+
+// Split splits str into entries and splits each entry into a key and a value.
+//
+// For example, OnRune(';').TrimResults(runematcher.Whitespace()).WithKeyValueSeparatorRune('=').
+// Split("a=1; b=2") returns the map {"a": "1", "b": "2"}.
+//
+// An error is returned if an entry cannot be split into exactly one key and one value, or if a key occurs more than
+// once.
+func (ms MapSplitter) Split(str string) (map[string]string, error) {
+	m := map[string]string{}
+	err := ms.split(str, func(k, v string) error {
+		_, dup := m[k]
+		if err := precond.CheckArgumentf(!dup, "duplicate key [%s] found", k); err != nil {
+			return err
+		}
+		m[k] = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SplitToEntries is like Split, but returns the keys and values in the order of their occurrence in str, i.e., the
+// key at index i belongs to the value at index i.
+func (ms MapSplitter) SplitToEntries(str string) (keys, values []string, err error) {
+	seen := map[string]bool{}
+	err = ms.split(str, func(k, v string) error {
+		if err := precond.CheckArgumentf(!seen[k], "duplicate key [%s] found", k); err != nil {
+			return err
+		}
+		seen[k] = true
+		keys, values = append(keys, k), append(values, v)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+func (ms MapSplitter) split(str string, f func(k, v string) error) error {
+	for it := ms.outer.Split(str); it.HasNext(); {
+		entry, _ := it.Next()
+		fields := ms.entry.Split(entry)
+
+		k, _ := fields.Next()
+		v, ok := fields.Next()
+		if err := precond.CheckArgumentf(ok && !fields.HasNext(), "chunk [%s] is not a valid entry", entry); err != nil {
+			return err
+		}
+		if err := f(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
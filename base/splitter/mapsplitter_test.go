@@ -0,0 +1,80 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitter_test
+
+import (
+	"testing"
+
+	"github.com/abc-inc/goava/base/runematcher"
+	"github.com/abc-inc/goava/base/splitter"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestMapSplitter_Split(t *testing.T) {
+	ms := fst(splitter.OnRune(';').TrimResults(ws).WithKeyValueSeparator("=>"))
+	m, err := ms.Split("boy=>tom ; girl=>tina;cat=>kitty ; dog=>tommy")
+	NoError(t, err)
+	Equal(t, map[string]string{"boy": "tom", "girl": "tina", "cat": "kitty", "dog": "tommy"}, m)
+
+	m, err = ms.Split("")
+	EqualError(t, err, "chunk [] is not a valid entry")
+	Nil(t, m)
+
+	m, err = splitter.OnRune(',').OmitEmptyStrings().WithKeyValueSeparatorRune(':').Split("")
+	NoError(t, err)
+	Empty(t, m)
+}
+
+func TestMapSplitter_Split_EmptyValues(t *testing.T) {
+	ms := splitter.OnRune('&').WithKeyValueSeparatorRune('=')
+	m, err := ms.Split("a=&=b")
+	NoError(t, err)
+	Equal(t, map[string]string{"a": "", "": "b"}, m)
+}
+
+func TestMapSplitter_Split_Invalid(t *testing.T) {
+	ms := splitter.OnRune(',').WithKeyValueSeparatorRune(':')
+
+	_, err := ms.Split("a:1,b")
+	EqualError(t, err, "chunk [b] is not a valid entry")
+
+	_, err = ms.Split("a:1,b:2:3")
+	EqualError(t, err, "chunk [b:2:3] is not a valid entry")
+
+	_, err = ms.Split("a:1,b:2,a:3")
+	EqualError(t, err, "duplicate key [a] found")
+
+	_, err = splitter.OnRune(',').WithKeyValueSeparator("")
+	EqualError(t, err, "the separator may not be the empty string")
+}
+
+func TestMapSplitter_KeyValueSplitter(t *testing.T) {
+	kv := fst(splitter.OnMatcher(runematcher.AnyOf(":=")).TrimResults(ws).Limit(2))
+	ms := splitter.OnRune('\n').OmitEmptyStrings().WithKeyValueSplitter(kv)
+	m, err := ms.Split("host = example.com\nurl: http://example.com:80\n")
+	NoError(t, err)
+	Equal(t, map[string]string{"host": "example.com", "url": "http://example.com:80"}, m)
+}
+
+func TestMapSplitter_SplitToEntries(t *testing.T) {
+	ms := splitter.OnRune('&').WithKeyValueSeparatorRune('=')
+	keys, values, err := ms.SplitToEntries("z=1&a=2&m=3")
+	NoError(t, err)
+	Equal(t, []string{"z", "a", "m"}, keys)
+	Equal(t, []string{"1", "2", "3"}, values)
+
+	_, _, err = ms.SplitToEntries("z=1&z=2")
+	EqualError(t, err, "duplicate key [z] found")
+}
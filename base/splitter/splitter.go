@@ -0,0 +1,321 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package splitter extracts non-overlapping substrings from an input string, typically by recognizing appearances of
+// a separator sequence.
+//
+// The separator can be specified as a single rune, fixed string, runematcher.Matcher or regular expression. Instead of
+// using a separator at all, a Splitter can divide strings into chunks of a fixed length.
+//
+// For example, this expression:
+//
+//	splitter.OnRune(',').SplitToSlice("foo,bar,qux")
+//
+// returns the slice ["foo", "bar", "qux"].
+//
+// By default, Splitter's behavior is simplistic and unassuming. The following expression returns
+// [" foo", "", "", " bar ", ""]:
+//
+//	splitter.OnRune(',').SplitToSlice(" foo,,, bar ,")
+//
+// Configuration methods like OmitEmptyStrings and TrimResults can be used to change this behavior.
+// Splitter instances are immutable. Invoking a configuration method has no effect on the receiving instance; it
+// returns a new splitter instance instead.
+package splitter
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/base/runematcher"
+)
+
+// strategy prepares the search for separators in s and returns a function, which returns the byte offsets of the start
+// and the end of the next separator at or after offset, or -1 if there is no further separator.
+type strategy func(s string) func(offset int) (sepStart, sepEnd int)
+
+// stateless returns a strategy, which searches s from offset without any preparation.
+func stateless(find func(s string, offset int) (sepStart, sepEnd int)) strategy {
+	return func(s string) func(int) (int, int) {
+		return func(offset int) (int, int) {
+			return find(s, offset)
+		}
+	}
+}
+
+// Splitter extracts non-overlapping substrings from an input string.
+type Splitter struct {
+	strategy  strategy
+	omitEmpty bool
+	trimmer   runematcher.Matcher
+	limit     int
+}
+
+func newSplitter(s strategy) Splitter {
+	return Splitter{strategy: s, trimmer: runematcher.None(), limit: -1}
+}
+
+// OnRune returns a splitter that uses the given single-rune separator.
+// For example, OnRune(',').SplitToSlice("foo,,bar") returns ["foo", "", "bar"].
+func OnRune(sep rune) Splitter {
+	return newSplitter(stateless(func(s string, offset int) (int, int) {
+		return indexRuneFunc(s, offset, func(r rune) bool { return r == sep })
+	}))
+}
+
+// On returns a splitter that uses the given fixed string as a separator.
+// For example, On(", ").SplitToSlice("foo, bar,baz") returns ["foo", "bar,baz"].
+//
+// An error is returned if sep is empty.
+func On(sep string) (Splitter, error) {
+	if err := precond.CheckArgumentf(sep != "", "the separator may not be the empty string"); err != nil {
+		return Splitter{}, err
+	}
+	return newSplitter(stateless(func(s string, offset int) (int, int) {
+		i := strings.Index(s[offset:], sep)
+		if i < 0 {
+			return -1, -1
+		}
+		return offset + i, offset + i + len(sep)
+	})), nil
+}
+
+// OnMatcher returns a splitter that considers any single rune matched by the given matcher to be a separator.
+// For example, OnMatcher(runematcher.AnyOf(";,")).SplitToSlice("foo,;bar,quux") returns ["foo", "", "bar", "quux"].
+func OnMatcher(m runematcher.Matcher) Splitter {
+	return newSplitter(stateless(func(s string, offset int) (int, int) {
+		return indexRuneFunc(s, offset, m.Matches)
+	}))
+}
+
+// indexRuneFunc returns the byte offsets of the start and the end of the first rune at or after offset satisfying f,
+// or -1 if there is none. Each invalid UTF-8 byte is passed to f as utf8.RuneError and spans exactly one byte.
+func indexRuneFunc(s string, offset int, f func(rune) bool) (int, int) {
+	for i := offset; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if f(r) {
+			return i, i + size
+		}
+		i += size
+	}
+	return -1, -1
+}
+
+// OnRegexp returns a splitter that considers any subsequence matching the given regular expression to be a separator.
+// For example, OnRegexp(regexp.MustCompile("\r?\n")).SplitToSlice(entireFile) splits a string into lines whether it
+// uses DOS-style or UNIX-style line terminators.
+//
+// The regular expression is matched against the entire string rather than the remainder after the previous separator.
+// Hence, anchors like ^, \A and \b refer to the input string, e.g., OnRegexp(regexp.MustCompile("^,")) only considers
+// a leading comma to be a separator.
+//
+// An error is returned if the regular expression matches the empty string.
+func OnRegexp(re *regexp.Regexp) (Splitter, error) {
+	err := precond.CheckArgumentf(!re.MatchString(""), "the pattern may not match the empty string: %s", re)
+	if err != nil {
+		return Splitter{}, err
+	}
+	return newSplitter(func(s string) func(int) (int, int) {
+		locs := re.FindAllStringIndex(s, -1)
+		return func(offset int) (int, int) {
+			for len(locs) > 0 && locs[0][0] < offset {
+				locs = locs[1:]
+			}
+			if len(locs) == 0 {
+				return -1, -1
+			}
+			return locs[0][0], locs[0][1]
+		}
+	}), nil
+}
+
+// FixedLength returns a splitter that divides strings into pieces of the given number of runes.
+// For example, FixedLength(2).SplitToSlice("abcde") returns ["ab", "cd", "e"]. The last piece can be smaller than
+// length but will never be empty.
+//
+// An error is returned if length is not positive.
+func FixedLength(length int) (Splitter, error) {
+	if err := precond.CheckArgumentf(length > 0, "the length may not be less than 1"); err != nil {
+		return Splitter{}, err
+	}
+	return newSplitter(stateless(func(s string, offset int) (int, int) {
+		i := offset
+		for n := 0; n < length; n++ {
+			if i >= len(s) {
+				return -1, -1
+			}
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+		}
+		if i >= len(s) {
+			return -1, -1
+		}
+		return i, i
+	})), nil
+}
+
+// OmitEmptyStrings returns a splitter that behaves equivalently to this splitter, but automatically omits empty
+// strings from the results.
+// For example, OnRune(',').OmitEmptyStrings().SplitToSlice(",a,,,b,c,,") returns ["a", "b", "c"].
+//
+// If either TrimResults option is also specified when creating a splitter, that splitter always trims results first
+// before checking for emptiness. So, for example, OnRune(':').OmitEmptyStrings().TrimResults(runematcher.Whitespace())
+// .SplitToSlice(": a: : b") returns ["a", "b"].
+func (s Splitter) OmitEmptyStrings() Splitter {
+	s.omitEmpty = true
+	return s
+}
+
+// TrimResults returns a splitter that behaves equivalently to this splitter, but removes all leading and trailing
+// runes matching the given matcher from each returned substring.
+// For example, OnRune(',').TrimResults(runematcher.AnyOf("_")).SplitToSlice("_a ,_b_ ,c__") returns
+// ["a ", "b_ ", "c"].
+//
+// To trim whitespace, use runematcher.Whitespace().
+func (s Splitter) TrimResults(trimmer runematcher.Matcher) Splitter {
+	s.trimmer = trimmer
+	return s
+}
+
+// Limit returns a splitter that behaves equivalently to this splitter, but stops splitting after it reaches the limit.
+// The limit defines the maximum number of items returned by the iterator, or the maximum size of the slice returned
+// by SplitToSlice.
+//
+// For example, OnRune(',').Limit(3).SplitToSlice("a,b,c,d") returns ["a", "b", "c,d"].
+// When omitting empty strings, the omitted strings do not count. Hence,
+// OnRune(',').Limit(3).OmitEmptyStrings().SplitToSlice("a,,,b,,,c,d") returns ["a", "b", "c,d"].
+// When trim is requested, all entries are trimmed, including the last. Hence,
+// OnRune(',').Limit(3).TrimResults(runematcher.Whitespace()).SplitToSlice(" a , b , c , d ") results in
+// ["a", "b", "c , d"].
+//
+// An error is returned if limit is not positive.
+func (s Splitter) Limit(limit int) (Splitter, error) {
+	if err := precond.CheckArgumentf(limit > 0, "must be greater than zero: %d", limit); err != nil {
+		return Splitter{}, err
+	}
+	s.limit = limit
+	return s, nil
+}
+
+// Split splits str into substrings lazily, i.e., the returned Iterator computes the next substring on demand.
+func (s Splitter) Split(str string) *Iterator {
+	it := &Iterator{s: s, str: str, find: s.strategy(str), limit: s.limit}
+	it.advance()
+	return it
+}
+
+// SplitToSlice splits str into substrings and returns them as a slice.
+func (s Splitter) SplitToSlice(str string) []string {
+	var parts []string
+	for it := s.Split(str); it.HasNext(); {
+		p, _ := it.Next()
+		parts = append(parts, p)
+	}
+	if parts == nil {
+		return []string{}
+	}
+	return parts
+}
+
+// Iterator lazily iterates over the substrings of a split string.
+type Iterator struct {
+	s      Splitter
+	str    string
+	find   func(offset int) (sepStart, sepEnd int)
+	offset int
+	limit  int
+	next   string
+	ok     bool
+}
+
+// HasNext returns true if there are more substrings.
+func (it *Iterator) HasNext() bool {
+	return it.ok
+}
+
+// Next returns the next substring and true, or the empty string and false if there are no more substrings.
+func (it *Iterator) Next() (string, bool) {
+	if !it.ok {
+		return "", false
+	}
+	next := it.next
+	it.advance()
+	return next, true
+}
+
+// advance computes the next substring.
+func (it *Iterator) advance() {
+	it.next, it.ok = "", false
+	str, trimmer := it.str, it.s.trimmer
+	nextStart := it.offset
+
+	for it.offset != -1 {
+		start, end := nextStart, 0
+		if sepStart, sepEnd := it.find(it.offset); sepStart == -1 {
+			end, it.offset = len(str), -1
+		} else {
+			end, it.offset = sepStart, sepEnd
+		}
+		if it.offset == nextStart {
+			// an empty separator at the current position: skip over the next rune
+			_, size := utf8.DecodeRuneInString(str[it.offset:])
+			if it.offset += size; size == 0 {
+				it.offset = -1
+			}
+			continue
+		}
+
+		start, end = trim(str, start, end, trimmer)
+		if it.s.omitEmpty && start == end {
+			nextStart = it.offset
+			continue
+		}
+
+		if it.limit == 1 {
+			// the last piece contains the remainder of the string
+			end = trimTrailing(str, start, len(str), trimmer)
+			it.offset = -1
+		} else {
+			it.limit--
+		}
+		it.next, it.ok = str[start:end], true
+		return
+	}
+}
+
+// trim returns the byte offsets of the substring of str[start:end] without leading and trailing runes matching m.
+func trim(str string, start, end int, m runematcher.Matcher) (int, int) {
+	for start < end {
+		r, size := utf8.DecodeRuneInString(str[start:end])
+		if !m.Matches(r) {
+			break
+		}
+		start += size
+	}
+	return start, trimTrailing(str, start, end, m)
+}
+
+// trimTrailing returns the byte offset of the end of str[start:end] without trailing runes matching m.
+func trimTrailing(str string, start, end int, m runematcher.Matcher) int {
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(str[start:end])
+		if !m.Matches(r) {
+			break
+		}
+		end -= size
+	}
+	return end
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitter_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/abc-inc/goava/base/runematcher"
+	"github.com/abc-inc/goava/base/splitter"
+	. "github.com/stretchr/testify/require"
+)
+
+var ws = runematcher.Whitespace()
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestOnRune(t *testing.T) {
+	s := splitter.OnRune(',')
+	Equal(t, []string{"a", "b", "c"}, s.SplitToSlice("a,b,c"))
+	Equal(t, []string{"a", "", "b"}, s.SplitToSlice("a,,b"))
+	Equal(t, []string{"", "a", "b", ""}, s.SplitToSlice(",a,b,"))
+	Equal(t, []string{""}, s.SplitToSlice(""))
+	Equal(t, []string{"", ""}, s.SplitToSlice(","))
+	Equal(t, []string{"", " foo", "", "", " bar ", ""}, s.SplitToSlice(", foo,,, bar ,"))
+	Equal(t, []string{"ä", "ö", "ü"}, splitter.OnRune('→').SplitToSlice("ä→ö→ü"))
+}
+
+func TestOnRune_InvalidUTF8(t *testing.T) {
+	Equal(t, []string{"a", "b"}, splitter.OnRune('\uFFFD').SplitToSlice("a\xffb"))
+	Equal(t, []string{"a", "", "b"}, splitter.OnRune('\uFFFD').SplitToSlice("a\xff\uFFFDb"))
+	Equal(t, []string{"a\xff", "b"}, splitter.OnRune(',').SplitToSlice("a\xff,b"))
+}
+
+func TestOnRune_OmitEmptyStrings(t *testing.T) {
+	s := splitter.OnRune(',').OmitEmptyStrings()
+	Equal(t, []string{"a", "b", "c"}, s.SplitToSlice(",a,,,b,c,,"))
+	Equal(t, []string{}, s.SplitToSlice(""))
+	Equal(t, []string{}, s.SplitToSlice(",,"))
+}
+
+func TestOnRune_TrimResults(t *testing.T) {
+	s := splitter.OnRune(',').TrimResults(ws)
+	Equal(t, []string{"", "foo", "", "", "bar", ""}, s.SplitToSlice(", foo,,, bar ,"))
+	Equal(t, []string{"foo", "bar"}, s.OmitEmptyStrings().SplitToSlice(" , foo ,, bar, "))
+
+	s = splitter.OnRune(',').TrimResults(runematcher.AnyOf("_"))
+	Equal(t, []string{"a ", "b_ ", "c"}, s.SplitToSlice("_a ,_b_ ,c__"))
+
+	s = splitter.OnRune(':').OmitEmptyStrings().TrimResults(ws)
+	Equal(t, []string{"a", "b"}, s.SplitToSlice(": a: : b"))
+}
+
+func TestOn(t *testing.T) {
+	s := fst(splitter.On(", "))
+	Equal(t, []string{"foo", "bar,baz"}, s.SplitToSlice("foo, bar,baz"))
+	Equal(t, []string{"a", "b", ""}, fst(splitter.On("::")).SplitToSlice("a::b::"))
+	Equal(t, []string{"a", ":b"}, fst(splitter.On("::")).SplitToSlice("a:::b"))
+
+	_, err := splitter.On("")
+	EqualError(t, err, "the separator may not be the empty string")
+}
+
+func TestOnMatcher(t *testing.T) {
+	s := splitter.OnMatcher(runematcher.AnyOf(";,"))
+	Equal(t, []string{"foo", "", "bar", "quux"}, s.SplitToSlice("foo,;bar,quux"))
+
+	s = splitter.OnMatcher(ws).OmitEmptyStrings()
+	Equal(t, []string{"日本", "語", "x"}, s.SplitToSlice(" 日本　語 \tx "))
+}
+
+func TestOnMatcher_InvalidUTF8(t *testing.T) {
+	Equal(t, []string{"", "", ""}, splitter.OnMatcher(runematcher.Any()).SplitToSlice("\xff\xfe"))
+	Equal(t, []string{"", "", "", ""}, splitter.OnMatcher(runematcher.Any()).SplitToSlice("a\xffb"))
+	Equal(t, []string{"a", "", "b"}, splitter.OnMatcher(runematcher.Is('\uFFFD')).SplitToSlice("a\xe2\x82b"))
+}
+
+func TestOnRegexp(t *testing.T) {
+	s := fst(splitter.OnRegexp(regexp.MustCompile(`\r?\n`)))
+	Equal(t, []string{"a", "b", "c", ""}, s.SplitToSlice("a\r\nb\nc\n"))
+
+	s = fst(splitter.OnRegexp(regexp.MustCompile(`[,;]+`)))
+	Equal(t, []string{"a", "b", "c"}, s.SplitToSlice("a,;b;c"))
+
+	_, err := splitter.OnRegexp(regexp.MustCompile("a*"))
+	EqualError(t, err, "the pattern may not match the empty string: a*")
+}
+
+func TestOnRegexp_Anchors(t *testing.T) {
+	s := fst(splitter.OnRegexp(regexp.MustCompile(`^,`)))
+	Equal(t, []string{"", "a,b"}, s.SplitToSlice(",a,b"))
+	Equal(t, []string{"", ",a"}, fst(splitter.OnRegexp(regexp.MustCompile(`\A,`))).SplitToSlice(",,a"))
+
+	s = fst(splitter.OnRegexp(regexp.MustCompile(`\bx`)))
+	Equal(t, []string{"", "ax ", ""}, s.SplitToSlice("xax x"))
+	Equal(t, []string{"", "x"}, s.SplitToSlice("xx"))
+
+	s = fst(splitter.OnRegexp(regexp.MustCompile(`(?m)^#`)))
+	Equal(t, []string{"", "a\n", "b"}, s.SplitToSlice("#a\n#b"))
+}
+
+func TestFixedLength(t *testing.T) {
+	s := fst(splitter.FixedLength(2))
+	Equal(t, []string{"ab", "cd", "e"}, s.SplitToSlice("abcde"))
+	Equal(t, []string{"ab", "cd"}, s.SplitToSlice("abcd"))
+	Equal(t, []string{""}, s.SplitToSlice(""))
+	Equal(t, []string{"äö", "ü"}, s.SplitToSlice("äöü"))
+	Equal(t, []string{"a", "b", "c"}, fst(splitter.FixedLength(1)).SplitToSlice("abc"))
+	Equal(t, []string{"abc"}, fst(splitter.FixedLength(4)).SplitToSlice("abc"))
+
+	_, err := splitter.FixedLength(0)
+	EqualError(t, err, "the length may not be less than 1")
+}
+
+func TestLimit(t *testing.T) {
+	s := fst(splitter.OnRune(',').Limit(3))
+	Equal(t, []string{"a", "b", "c,d"}, s.SplitToSlice("a,b,c,d"))
+	Equal(t, []string{"a", "b"}, s.SplitToSlice("a,b"))
+	Equal(t, []string{"a", "b", "c,d"}, fst(splitter.OnRune(',').OmitEmptyStrings().Limit(3)).
+		SplitToSlice("a,,,b,,,c,d"))
+	Equal(t, []string{"a", "b", "c , d"}, fst(splitter.OnRune(',').TrimResults(ws).Limit(3)).
+		SplitToSlice(" a , b , c , d "))
+	Equal(t, []string{"ab", "cde"}, fst(fst(splitter.FixedLength(2)).Limit(2)).SplitToSlice("abcde"))
+	Equal(t, []string{"a,b,c"}, fst(splitter.OnRune(',').Limit(1)).SplitToSlice("a,b,c"))
+
+	_, err := splitter.OnRune(',').Limit(0)
+	EqualError(t, err, "must be greater than zero: 0")
+}
+
+func TestSplit_Lazy(t *testing.T) {
+	calls := 0
+	m := runematcher.ForPredicate(func(r rune) bool {
+		calls++
+		return r == ','
+	})
+
+	it := splitter.OnMatcher(m).Split("a,b,c")
+	True(t, it.HasNext())
+	Equal(t, 2, calls)
+
+	v, ok := it.Next()
+	True(t, ok)
+	Equal(t, "a", v)
+	Equal(t, 4, calls)
+
+	Equal(t, "b", fst2(it.Next()))
+	Equal(t, "c", fst2(it.Next()))
+	False(t, it.HasNext())
+	v, ok = it.Next()
+	False(t, ok)
+	Equal(t, "", v)
+}
+
+func fst2(v string, _ bool) string {
+	return v
+}
+
+func TestSplitter_Immutable(t *testing.T) {
+	s := splitter.OnRune(',')
+	_ = s.OmitEmptyStrings().TrimResults(ws)
+	Equal(t, []string{"", " a"}, s.SplitToSlice(", a"))
+}
@@ -0,0 +1,33 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strs_test
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/base/strs"
+)
+
+func Example() {
+	fmt.Println(strs.PadStart("7", 3, '0'))
+	fmt.Println(strs.CommonPrefix("goava", "guava"))
+	fmt.Println(strs.LenientFormat("expected %s but got %s", 1, 2, 3))
+	fmt.Println(strs.Truncate("🇦🇹🇩🇪🇨🇭", 2, "…"))
+	// Output:
+	// 007
+	// g
+	// expected 1 but got 2 [3]
+	// 🇦🇹… <nil>
+}
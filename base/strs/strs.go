@@ -0,0 +1,229 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package strs contains static utility functions pertaining to string instances.
+//
+// In contrast to the functions of the standard library's strings package, lengths are measured in runes rather than in
+// bytes, and the results never contain partial runes.
+package strs
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/rivo/uniseg"
+)
+
+// NilToEmpty returns the given string if it is non-nil; the empty string otherwise.
+func NilToEmpty(str *string) string {
+	if str == nil {
+		return ""
+	}
+	return *str
+}
+
+// EmptyToNil returns a pointer to the given string if it is nonempty; nil otherwise.
+func EmptyToNil(str string) *string {
+	if str == "" {
+		return nil
+	}
+	return &str
+}
+
+// IsNilOrEmpty returns true if the given string is nil or is the empty string.
+func IsNilOrEmpty(str *string) bool {
+	return str == nil || *str == ""
+}
+
+// PadStart returns a string, of length at least minLength runes, consisting of str prepended with as many copies of
+// padChar as are necessary to reach that length.
+// For example,
+//
+//	PadStart("7", 3, '0') returns "007"
+//	PadStart("2010", 3, '0') returns "2010"
+func PadStart(str string, minLength int, padChar rune) string {
+	n := minLength - utf8.RuneCountInString(str)
+	if n <= 0 {
+		return str
+	}
+	return strings.Repeat(string(padChar), n) + str
+}
+
+// PadEnd returns a string, of length at least minLength runes, consisting of str appended with as many copies of
+// padChar as are necessary to reach that length.
+// For example,
+//
+//	PadEnd("4.", 5, '0') returns "4.000"
+//	PadEnd("2010", 3, '!') returns "2010"
+func PadEnd(str string, minLength int, padChar rune) string {
+	n := minLength - utf8.RuneCountInString(str)
+	if n <= 0 {
+		return str
+	}
+	return str + strings.Repeat(string(padChar), n)
+}
+
+// Repeat returns a string consisting of a specific number of concatenated copies of an input string.
+// For example, Repeat("hey", 3) returns the string "heyheyhey".
+//
+// An error is returned if count is negative or if the resulting string would be too large.
+func Repeat(str string, count int) (string, error) {
+	if _, err := precond.CheckNonnegative(count, "count"); err != nil {
+		return "", err
+	}
+	if count <= 1 || str == "" {
+		if count == 0 {
+			return "", nil
+		}
+		return str, nil
+	}
+
+	err := precond.CheckArgumentf(len(str) <= math.MaxInt/count, "required length too large: %d * %d", len(str), count)
+	if err != nil {
+		return "", err
+	}
+	return strings.Repeat(str, count), nil
+}
+
+// CommonPrefix returns the longest string prefix such that a.HasPrefix(prefix) && b.HasPrefix(prefix), taking care
+// not to split multi-byte runes.
+// If a and b have no common prefix, returns the empty string.
+func CommonPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) {
+		_, size := utf8.DecodeRuneInString(a[n:])
+		if !strings.HasPrefix(b[n:], a[n:n+size]) {
+			break
+		}
+		n += size
+	}
+	return a[:n]
+}
+
+// CommonSuffix returns the longest string suffix such that a.HasSuffix(suffix) && b.HasSuffix(suffix), taking care
+// not to split multi-byte runes.
+// If a and b have no common suffix, returns the empty string.
+func CommonSuffix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) {
+		_, size := utf8.DecodeLastRuneInString(a[:len(a)-n])
+		if !strings.HasSuffix(b[:len(b)-n], a[len(a)-n-size:len(a)-n]) {
+			break
+		}
+		n += size
+	}
+	return a[len(a)-n:]
+}
+
+// LenientFormat returns the given template string with each occurrence of "%s" replaced with the corresponding
+// argument value from args; or, if the placeholder and argument counts do not match, returns a best-effort form of
+// that string. It never panics.
+//
+// Each argument is converted by its String or Error method, or formatted like fmt.Sprint otherwise. If the conversion
+// panics, the argument is represented by its type and the panic value, e.g., "<*foo.Bar threw boom>".
+//
+// If there are more arguments than placeholders, the unmatched arguments are appended to the end of the formatted
+// message in square brackets, separated by commas, e.g., LenientFormat("%s", 1, 2) returns "1 [2]".
+// If there are more placeholders than arguments, the unmatched placeholders are left as they are.
+//
+// Note that "%s" is the only supported placeholder; any other "%" sequences are copied verbatim.
+func LenientFormat(template string, args ...interface{}) string {
+	sb := strings.Builder{}
+	i := 0
+	for i < len(args) {
+		pos := strings.Index(template, "%s")
+		if pos < 0 {
+			break
+		}
+		sb.WriteString(template[:pos])
+		sb.WriteString(lenientToString(args[i]))
+		template = template[pos+2:]
+		i++
+	}
+	sb.WriteString(template)
+
+	if i < len(args) {
+		sb.WriteString(" [")
+		for j, a := range args[i:] {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(lenientToString(a))
+		}
+		sb.WriteString("]")
+	}
+	return sb.String()
+}
+
+func lenientToString(v interface{}) (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s = fmt.Sprintf("<%T threw %v>", v, r)
+		}
+	}()
+
+	switch x := v.(type) {
+	case string:
+		return x
+	case fmt.Stringer:
+		return x.String()
+	case error:
+		return x.Error()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Truncate truncates the given string to the given maximum length, measured in grapheme clusters (i.e.,
+// user-perceived characters). If the length of the string is greater than maxLen, the returned string will be exactly
+// maxLen grapheme clusters long, and will end with the given truncation indicator.
+// Otherwise, the string will be returned with no changes.
+//
+// Examples:
+//
+//	Truncate("foobar", 7, "...") returns "foobar"
+//	Truncate("foobar", 5, "...") returns "fo..."
+//	Truncate("🇦🇹🇩🇪🇨🇭", 2, "…") returns "🇦🇹…"
+//
+// In contrast to ascii.Truncate, it never splits runes, combining character sequences, emoji sequences or other
+// grapheme clusters.
+// An error is returned if maxLen is less than the length of the truncation indicator.
+func Truncate(str string, maxLen int, truncInd string) (string, error) {
+	nTruncInd := uniseg.GraphemeClusterCount(truncInd)
+	n := maxLen - nTruncInd
+	err := precond.CheckArgumentf(n >= 0,
+		"maxLen (%d) must be >= length of the truncation indicator (%d)", maxLen, nTruncInd)
+	if err != nil {
+		return "", err
+	}
+
+	end, rest, state := 0, str, -1
+	for i := 0; i < maxLen; i++ {
+		if rest == "" {
+			return str, nil
+		}
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		if i < n {
+			end += len(cluster)
+		}
+	}
+	if rest == "" {
+		return str, nil
+	}
+	return str[:end] + truncInd, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strs_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/base/strs"
+	. "github.com/stretchr/testify/require"
+)
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestNilToEmpty(t *testing.T) {
+	s := "a"
+	Equal(t, "", strs.NilToEmpty(nil))
+	Equal(t, "a", strs.NilToEmpty(&s))
+}
+
+func TestEmptyToNil(t *testing.T) {
+	Nil(t, strs.EmptyToNil(""))
+	Equal(t, "a", *strs.EmptyToNil("a"))
+}
+
+func TestIsNilOrEmpty(t *testing.T) {
+	e, s := "", "a"
+	True(t, strs.IsNilOrEmpty(nil))
+	True(t, strs.IsNilOrEmpty(&e))
+	False(t, strs.IsNilOrEmpty(&s))
+}
+
+func TestPadStart(t *testing.T) {
+	Equal(t, "", strs.PadStart("", 0, '-'))
+	Equal(t, "---", strs.PadStart("", 3, '-'))
+	Equal(t, "007", strs.PadStart("7", 3, '0'))
+	Equal(t, "2010", strs.PadStart("2010", 3, '0'))
+	Equal(t, "x", strs.PadStart("x", -1, '-'))
+	Equal(t, "··äö", strs.PadStart("äö", 4, '·'))
+}
+
+func TestPadEnd(t *testing.T) {
+	Equal(t, "", strs.PadEnd("", 0, '-'))
+	Equal(t, "---", strs.PadEnd("", 3, '-'))
+	Equal(t, "4.000", strs.PadEnd("4.", 5, '0'))
+	Equal(t, "2010", strs.PadEnd("2010", 3, '!'))
+	Equal(t, "x", strs.PadEnd("x", -1, '-'))
+	Equal(t, "äö··", strs.PadEnd("äö", 4, '·'))
+}
+
+func TestRepeat(t *testing.T) {
+	Equal(t, "", fst(strs.Repeat("hey", 0)))
+	Equal(t, "hey", fst(strs.Repeat("hey", 1)))
+	Equal(t, "heyheyhey", fst(strs.Repeat("hey", 3)))
+	Equal(t, "", fst(strs.Repeat("", 3)))
+	Equal(t, "", fst(strs.Repeat("", math.MaxInt)))
+
+	_, err := strs.Repeat("hey", -1)
+	EqualError(t, err, "count cannot be negative but was: -1")
+	True(t, errors.Is(err, precond.ErrIllegalArgument))
+
+	_, err = strs.Repeat("hey", math.MaxInt/2)
+	ErrorIs(t, err, precond.ErrIllegalArgument)
+	ErrorContains(t, err, "required length too large")
+}
+
+func TestCommonPrefix(t *testing.T) {
+	Equal(t, "", strs.CommonPrefix("", ""))
+	Equal(t, "", strs.CommonPrefix("abc", ""))
+	Equal(t, "", strs.CommonPrefix("", "abc"))
+	Equal(t, "", strs.CommonPrefix("xyz", "abcxyz"))
+	Equal(t, "a", strs.CommonPrefix("abc", "aaaaa"))
+	Equal(t, "aa", strs.CommonPrefix("aa", "aaaaa"))
+	Equal(t, "abc", strs.CommonPrefix("abc", "abcdef"))
+	// 'ä' (U+00E4) and 'å' (U+00E5) share their first byte
+	Equal(t, "x", strs.CommonPrefix("xä", "xå"))
+	Equal(t, "x😀", strs.CommonPrefix("x😀a", "x😀b"))
+	Equal(t, "x", strs.CommonPrefix("x😀", "x😁"))
+}
+
+func TestCommonSuffix(t *testing.T) {
+	Equal(t, "", strs.CommonSuffix("", ""))
+	Equal(t, "", strs.CommonSuffix("abc", ""))
+	Equal(t, "", strs.CommonSuffix("", "abc"))
+	Equal(t, "", strs.CommonSuffix("xyz", "xyzabc"))
+	Equal(t, "c", strs.CommonSuffix("abc", "ccccc"))
+	Equal(t, "aa", strs.CommonSuffix("aa", "aaaaa"))
+	Equal(t, "abc", strs.CommonSuffix("abc", "xyzabc"))
+	// '∀' (U+2200) and 'Ȁ' (U+0200) share their last byte
+	Equal(t, "x", strs.CommonSuffix("∀x", "Ȁx"))
+	Equal(t, "😀x", strs.CommonSuffix("a😀x", "b😀x"))
+	Equal(t, "", strs.CommonSuffix("😀", "😐"))
+}
+
+type panicky struct{}
+
+func (panicky) String() string { panic("boom") }
+
+func TestLenientFormat(t *testing.T) {
+	Equal(t, "", strs.LenientFormat(""))
+	Equal(t, "%s", strs.LenientFormat("%s"))
+	Equal(t, "5", strs.LenientFormat("%s", 5))
+	Equal(t, "foo [5]", strs.LenientFormat("foo", 5))
+	Equal(t, "foo [5, 6, 7]", strs.LenientFormat("foo", 5, 6, 7))
+	Equal(t, "%s 1 2", strs.LenientFormat("%s %s %s", "%s", 1, 2))
+	Equal(t, " [5, 6]", strs.LenientFormat("", 5, 6))
+	Equal(t, "123", strs.LenientFormat("%s%s%s", 1, 2, 3))
+	Equal(t, "1%s%s", strs.LenientFormat("%s%s%s", 1))
+	Equal(t, "5 + 6 = 11", strs.LenientFormat("%s + 6 = 11", 5))
+	Equal(t, "5 + 6 = 11", strs.LenientFormat("5 + %s = 11", 6))
+	Equal(t, "5 + 6 = 11", strs.LenientFormat("5 + 6 = %s", 11))
+	Equal(t, "5 + 6 = 11", strs.LenientFormat("%s + %s = %s", 5, 6, 11))
+	Equal(t, "<nil> [<nil>, <nil>]", strs.LenientFormat("%s", nil, nil, nil))
+	Equal(t, "100% %d [1]", strs.LenientFormat("100% %d", 1))
+	Equal(t, "EOF", strs.LenientFormat("%s", errors.New("EOF")))
+}
+
+func TestLenientFormat_Panic(t *testing.T) {
+	Equal(t, "a <strs_test.panicky threw boom> [b]", strs.LenientFormat("a %s", panicky{}, "b"))
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		str      string
+		maxLen   int
+		truncInd string
+		want     string
+	}{
+		{"foobar", 10, "...", "foobar"},
+		{"foobar", 6, "...", "foobar"},
+		{"foobar", 5, "...", "fo..."},
+		{"foobar", 3, "...", "..."},
+		{"foobar", 5, "…", "foob…"},
+		{"", 0, "", ""},
+		{"äöüäöü", 5, "...", "äö..."},
+		// combining characters
+		{"a\u0308o\u0308u\u0308", 3, "", "a\u0308o\u0308u\u0308"},
+		{"a\u0308o\u0308u\u0308", 2, "…", "a\u0308…"},
+		// regional indicators and ZWJ sequences
+		{"🇦🇹🇩🇪🇨🇭", 2, "…", "🇦🇹…"},
+		{"👩‍👩‍👧‍👦👨‍👩‍👧", 1, "", "👩‍👩‍👧‍👦"},
+	}
+
+	for _, tc := range tests {
+		Equal(t, tc.want, fst(strs.Truncate(tc.str, tc.maxLen, tc.truncInd)), "%q", tc.str)
+	}
+
+	_, err := strs.Truncate("foobar", 2, "...")
+	EqualError(t, err, "maxLen (2) must be >= length of the truncation indicator (3)")
+	_, err = strs.Truncate("foobar", 0, "🇦🇹")
+	EqualError(t, err, "maxLen (0) must be >= length of the truncation indicator (1)")
+}
+
+func TestTruncate_Long(t *testing.T) {
+	s := strings.Repeat("e\u0301", 1000)
+	Equal(t, s, fst(strs.Truncate(s, 1000, "...")))
+	Equal(t, strings.Repeat("e\u0301", 996)+"...", fst(strs.Truncate(s, 999, "...")))
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package verify provides functions for verifying that certain conditions hold, which are expected to be true
+// regardless of how the calling function was invoked.
+//
+// In contrast to package precond, which checks whether the caller has made a mistake, a failed verification indicates
+// a problem in the calling function itself or in its dependencies, e.g., a library returning an unexpected result.
+// Hence, it is the counterpart of Guava's Verify and VerifyException.
+//
+//	bill, err := service.Bill(order)
+//	if err != nil {
+//		return err
+//	}
+//	if err := verify.Thatf(bill.Amount >= 0, "negative amount %d for order %s", bill.Amount, order.ID); err != nil {
+//		return err
+//	}
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/abc-inc/goava/internal/errsite"
+	"github.com/abc-inc/goava/internal/reflectutil"
+)
+
+// ErrVerification is matched by every *VerificationError by means of errors.Is.
+var ErrVerification = errors.New("verification failed")
+
+// VerificationError indicates that a verification has failed.
+type VerificationError struct {
+	msg   string
+	cause error
+	site  errsite.Site
+}
+
+func (e *VerificationError) Error() string {
+	return e.msg
+}
+
+// Is reports whether target is ErrVerification.
+func (e *VerificationError) Is(target error) bool {
+	return target == ErrVerification
+}
+
+// Unwrap returns the cause of the error, if any.
+func (e *VerificationError) Unwrap() error {
+	return e.cause
+}
+
+// Caller returns the location ("file:line") of the outermost call into Goava, which returned the error.
+// If a verification fails within Goava, this is the call site in the calling code rather than the verification itself.
+func (e *VerificationError) Caller() string {
+	return e.site.String()
+}
+
+// Format formats the error according to the fmt.Formatter interface.
+// The verb %+v additionally prints the location of the failed verification and the cause, if any.
+func (e *VerificationError) Format(s fmt.State, verb rune) {
+	errsite.Format(s, verb, e.msg, e.site, e.cause)
+}
+
+// That ensures that expr is true.
+func That(expr bool) error {
+	return ThatWrapf(expr, nil, "expected a valid value")
+}
+
+// Thatf ensures that expr is true.
+func Thatf(expr bool, desc string, args ...interface{}) error {
+	return ThatWrapf(expr, nil, desc, args...)
+}
+
+// ThatWrapf ensures that expr is true.
+// The returned error wraps the given cause, which may be nil.
+func ThatWrapf(expr bool, cause error, desc string, args ...interface{}) error {
+	if !expr {
+		return &VerificationError{fmt.Sprintf(desc, args...), cause, errsite.Capture()}
+	}
+	return nil
+}
+
+// NotNil ensures that obj is not nil.
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func NotNil[T any](obj T) (T, error) {
+	return NotNilf(obj, "expected a non-nil reference")
+}
+
+// NotNilf ensures that obj is not nil.
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func NotNilf[T any](obj T, desc string, args ...interface{}) (T, error) {
+	return NotNilWrapf(obj, nil, desc, args...)
+}
+
+// NotNilWrapf ensures that obj is not nil.
+// The returned error wraps the given cause, which may be nil.
+//
+// Besides the nil interface, nil pointers, maps, slices, channels, functions and interfaces are considered nil, even
+// if they are wrapped in an interface.
+func NotNilWrapf[T any](obj T, cause error, desc string, args ...interface{}) (T, error) {
+	if reflectutil.IsNil(obj) {
+		return obj, &VerificationError{fmt.Sprintf(desc, args...), cause, errsite.Capture()}
+	}
+	return obj, nil
+}
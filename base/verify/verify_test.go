@@ -0,0 +1,79 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/abc-inc/goava/base/verify"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestThat(t *testing.T) {
+	NoError(t, verify.That(true))
+	err := verify.That(false)
+	EqualError(t, err, "expected a valid value")
+	ErrorIs(t, err, verify.ErrVerification)
+}
+
+func TestThatf(t *testing.T) {
+	NoError(t, verify.Thatf(true, "unused"))
+	EqualError(t, verify.Thatf(false, "%d > %d", 1, 2), "1 > 2")
+}
+
+func TestThatWrapf(t *testing.T) {
+	err := verify.ThatWrapf(false, io.EOF, "unexpected %s", "end")
+	EqualError(t, err, "unexpected end")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, verify.ErrVerification)
+	Equal(t, io.EOF, errors.Unwrap(err))
+	Regexp(t, `^unexpected end\n\tat .*/base/verify/verify_test\.go:\d+\ncaused by: EOF$`, fmt.Sprintf("%+v", err))
+	Equal(t, `"unexpected end"`, fmt.Sprintf("%q", err))
+}
+
+func TestNotNil(t *testing.T) {
+	v, err := verify.NotNil("a")
+	NoError(t, err)
+	Equal(t, "a", v)
+
+	_, err = verify.NotNil[any](nil)
+	EqualError(t, err, "expected a non-nil reference")
+
+	_, err = verify.NotNil((*int)(nil))
+	EqualError(t, err, "expected a non-nil reference")
+
+	_, err = verify.NotNil[any](map[string]int(nil))
+	EqualError(t, err, "expected a non-nil reference")
+
+	_, err = verify.NotNilf([]int(nil), "no %s", "user")
+	EqualError(t, err, "no user")
+}
+
+func TestNotNilWrapf(t *testing.T) {
+	_, err := verify.NotNilWrapf((*int)(nil), io.EOF, "no %s", "user")
+	EqualError(t, err, "no user")
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, verify.ErrVerification)
+}
+
+func TestVerificationError(t *testing.T) {
+	var ve *verify.VerificationError
+	ErrorAs(t, fmt.Errorf("wrapped: %w", verify.That(false)), &ve)
+	Regexp(t, `/base/verify/verify_test\.go:\d+$`, ve.Caller())
+	Nil(t, ve.Unwrap())
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runematcher
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abc-inc/goava/base/runematcher"
+)
+
+// str is a mix of ASCII, Latin-1, CJK and supplementary characters, which is repeated to benchmark longer strings.
+var str = strings.Repeat("Lorem ipsum 123\t dolor sit amet, 東京 ４５６ \U0001F600 consectetur.\n", 1_000)
+
+// matcher is a composite matcher, which evaluates a chain of matchers for every character.
+var matcher = runematcher.Whitespace().Or(runematcher.Digit()).And(runematcher.ASCII().Negate())
+
+func benchmarkMatcher(b *testing.B, m runematcher.Matcher) {
+	b.Run("IndexIn", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m.IndexIn(str, 0)
+		}
+	})
+	b.Run("CountIn", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m.CountIn(str)
+		}
+	})
+	b.Run("RemoveFrom", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m.RemoveFrom(str)
+		}
+	})
+}
+
+func BenchmarkComposite(b *testing.B) {
+	benchmarkMatcher(b, matcher)
+}
+
+func BenchmarkPrecomputed(b *testing.B) {
+	benchmarkMatcher(b, matcher.Precomputed())
+}
+
+func BenchmarkPrecomputedBitSet(b *testing.B) {
+	m := runematcher.ForPredicate(func(r rune) bool { return r < 0x3000 && r%3 == 0 })
+	b.Run("Predicate", func(b *testing.B) { benchmarkMatcher(b, m.Or(runematcher.Whitespace())) })
+	b.Run("Precomputed", func(b *testing.B) { benchmarkMatcher(b, m.Or(runematcher.Whitespace()).Precomputed()) })
+}
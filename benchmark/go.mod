@@ -14,7 +14,7 @@
 
 module github.com/abc-inc/goava/benchmark
 
-go 1.19
+go 1.21
 
 require (
 	github.com/abc-inc/goava v0.0.0-00010101000000-000000000000
@@ -30,6 +30,7 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/jonboulle/clockwork v0.3.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rivo/uniseg v0.4.7 // indirect
 	golang.org/x/text v0.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
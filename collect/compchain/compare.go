@@ -0,0 +1,128 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compchain
+
+import (
+	"bytes"
+	"cmp"
+	"time"
+
+	"github.com/abc-inc/goava/base/opt"
+)
+
+// Compare compares two values of an ordered type, if the result of the comparison chain has not already been
+// determined.
+//
+// Unlike the type-specific methods of ComparisonChain, it works for any type whose underlying type is an integer,
+// float or string. Floats are compared like cmp.Compare does, i.e., NaN is considered less than any other value.
+//
+// Since methods cannot have type parameters, it takes the chain as first argument, e.g.:
+//
+//	c := compchain.Start()
+//	c = compchain.Compare(c, u1.Name, u2.Name)
+//	c = compchain.Compare(c, u1.ID, u2.ID)
+//	return c.Result()
+func Compare[T cmp.Ordered](c ComparisonChain, left, right T) ComparisonChain {
+	if c != a {
+		return c
+	}
+	return classify(cmp.Compare(left, right))
+}
+
+// CompareFunc compares two values using a comparator, if the result of the comparison chain has not already been
+// determined.
+//
+// The comparator is not invoked if the result has already been determined.
+func CompareFunc[T any](c ComparisonChain, left, right T, cmp func(l, r T) int) ComparisonChain {
+	if c != a {
+		return c
+	}
+	return classify(cmp(left, right))
+}
+
+// CompareTime compares two time instants, if the result of the comparison chain has not already been determined.
+func CompareTime(c ComparisonChain, left, right time.Time) ComparisonChain {
+	return CompareFunc(c, left, right, time.Time.Compare)
+}
+
+// CompareBytes compares two byte slices lexicographically, if the result of the comparison chain has not already been
+// determined.
+//
+// A nil slice is considered equal to an empty slice.
+func CompareBytes(c ComparisonChain, left, right []byte) ComparisonChain {
+	return CompareFunc(c, left, right, bytes.Compare)
+}
+
+// CompareNilsFirst compares two pointers, considering nil to be less than any non-nil pointer, if the result of the
+// comparison chain has not already been determined.
+//
+// Two nil pointers are equal, and non-nil pointers are compared by applying cmp to the values they point to.
+func CompareNilsFirst[T any](c ComparisonChain, left, right *T, cmp func(l, r T) int) ComparisonChain {
+	return CompareFunc(c, left, right, nilsFirst(cmp))
+}
+
+// CompareNilsLast compares two pointers, considering nil to be greater than any non-nil pointer, if the result of the
+// comparison chain has not already been determined.
+//
+// Two nil pointers are equal, and non-nil pointers are compared by applying cmp to the values they point to.
+func CompareNilsLast[T any](c ComparisonChain, left, right *T, cmp func(l, r T) int) ComparisonChain {
+	return CompareFunc(c, right, left, nilsFirst(func(l, r T) int { return cmp(r, l) }))
+}
+
+// CompareAbsentFirst compares two Optional values, considering an absent value to be less than any present value, if
+// the result of the comparison chain has not already been determined.
+//
+// Two absent values are equal, and present values are compared by applying cmp to the contained values.
+func CompareAbsentFirst[T any](c ComparisonChain, left, right opt.Optional[T], cmp func(l, r T) int) ComparisonChain {
+	return CompareFunc(c, left, right, absentFirst(cmp))
+}
+
+// CompareAbsentLast compares two Optional values, considering an absent value to be greater than any present value, if
+// the result of the comparison chain has not already been determined.
+//
+// Two absent values are equal, and present values are compared by applying cmp to the contained values.
+func CompareAbsentLast[T any](c ComparisonChain, left, right opt.Optional[T], cmp func(l, r T) int) ComparisonChain {
+	return CompareFunc(c, right, left, absentFirst(func(l, r T) int { return cmp(r, l) }))
+}
+
+func nilsFirst[T any](cmp func(l, r T) int) func(l, r *T) int {
+	return func(l, r *T) int {
+		switch {
+		case l == nil && r == nil:
+			return 0
+		case l == nil:
+			return -1
+		case r == nil:
+			return 1
+		default:
+			return cmp(*l, *r)
+		}
+	}
+}
+
+func absentFirst[T any](cmp func(l, r T) int) func(l, r opt.Optional[T]) int {
+	return func(l, r opt.Optional[T]) int {
+		switch {
+		case !l.IsPresent() && !r.IsPresent():
+			return 0
+		case !l.IsPresent():
+			return -1
+		case !r.IsPresent():
+			return 1
+		default:
+			return cmp(l.OrNil(), r.OrNil())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compchain_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/base/opt"
+	"github.com/abc-inc/goava/collect/compchain"
+	. "github.com/stretchr/testify/require"
+)
+
+func dontCallMe[T any](l, r T) int { panic("don't call me") }
+
+func ptr[T any](v T) *T { return &v }
+
+func TestCompare(t *testing.T) {
+	type myInt int
+	Equal(t, 0, compchain.Compare(compchain.Start(), myInt(1), myInt(1)).Result())
+	Greater(t, 0, compchain.Compare(compchain.Start(), myInt(1), myInt(2)).Result())
+	Less(t, 0, compchain.Compare(compchain.Start(), "b", "a").Result())
+	Greater(t, 0, compchain.Compare(compchain.Start(), math.NaN(), 0.0).Result())
+	Equal(t, 0, compchain.Compare(compchain.Start(), math.NaN(), math.NaN()).Result())
+}
+
+func TestCompare_ShortCircuit(t *testing.T) {
+	c := compchain.Compare(compchain.Start(), "a", "b")
+	c = compchain.CompareFunc(c, dontCompareMe, dontCompareMe, dontCallMe[struct{}])
+	c = compchain.Compare(c, 2, 1)
+	Greater(t, 0, c.Result())
+
+	c = compchain.Start().CompareString("b", "a")
+	c = compchain.CompareNilsFirst(c, ptr(1), ptr(2), dontCallMe[int])
+	c = compchain.CompareAbsentLast(c, opt.None[int](), opt.Some(1), dontCallMe[int])
+	Less(t, 0, c.Result())
+}
+
+func TestCompareFunc(t *testing.T) {
+	c := compchain.CompareFunc(compchain.Start(), "a", "A", func(l, r string) int {
+		return strings.Compare(strings.ToLower(l), strings.ToLower(r))
+	})
+	Equal(t, 0, c.Result())
+	Greater(t, 0, compchain.CompareFunc(c, "a", "b", strings.Compare).Result())
+}
+
+func TestCompareTime(t *testing.T) {
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.In(time.FixedZone("CET", 3600))
+	Equal(t, 0, compchain.CompareTime(compchain.Start(), t1, t2).Result())
+	Greater(t, 0, compchain.CompareTime(compchain.Start(), t1, t1.Add(time.Nanosecond)).Result())
+	Less(t, 0, compchain.CompareTime(compchain.Start(), t1, time.Time{}).Result())
+}
+
+func TestCompareBytes(t *testing.T) {
+	Equal(t, 0, compchain.CompareBytes(compchain.Start(), nil, []byte{}).Result())
+	Greater(t, 0, compchain.CompareBytes(compchain.Start(), []byte("a"), []byte("ab")).Result())
+	Less(t, 0, compchain.CompareBytes(compchain.Start(), []byte("b"), []byte("ab")).Result())
+}
+
+func TestCompareNilsFirst(t *testing.T) {
+	cmpInt := func(l, r int) int { return l - r }
+	Equal(t, 0, compchain.CompareNilsFirst[int](compchain.Start(), nil, nil, dontCallMe[int]).Result())
+	Greater(t, 0, compchain.CompareNilsFirst(compchain.Start(), nil, ptr(1), cmpInt).Result())
+	Less(t, 0, compchain.CompareNilsFirst(compchain.Start(), ptr(1), nil, cmpInt).Result())
+	Greater(t, 0, compchain.CompareNilsFirst(compchain.Start(), ptr(1), ptr(2), cmpInt).Result())
+}
+
+func TestCompareNilsLast(t *testing.T) {
+	cmpInt := func(l, r int) int { return l - r }
+	Equal(t, 0, compchain.CompareNilsLast[int](compchain.Start(), nil, nil, dontCallMe[int]).Result())
+	Less(t, 0, compchain.CompareNilsLast(compchain.Start(), nil, ptr(1), cmpInt).Result())
+	Greater(t, 0, compchain.CompareNilsLast(compchain.Start(), ptr(1), nil, cmpInt).Result())
+	Greater(t, 0, compchain.CompareNilsLast(compchain.Start(), ptr(1), ptr(2), cmpInt).Result())
+}
+
+func TestCompareAbsentFirst(t *testing.T) {
+	one, two, abs := opt.Some(1), opt.Some(2), opt.None[int]()
+	cmpInt := func(l, r int) int { return l - r }
+	Equal(t, 0, compchain.CompareAbsentFirst(compchain.Start(), abs, abs, dontCallMe[int]).Result())
+	Greater(t, 0, compchain.CompareAbsentFirst(compchain.Start(), abs, one, cmpInt).Result())
+	Less(t, 0, compchain.CompareAbsentFirst(compchain.Start(), one, abs, cmpInt).Result())
+	Greater(t, 0, compchain.CompareAbsentFirst(compchain.Start(), one, two, cmpInt).Result())
+}
+
+func TestCompareAbsentLast(t *testing.T) {
+	one, two, abs := opt.Some(1), opt.Some(2), opt.None[int]()
+	cmpInt := func(l, r int) int { return l - r }
+	Equal(t, 0, compchain.CompareAbsentLast(compchain.Start(), abs, abs, dontCallMe[int]).Result())
+	Less(t, 0, compchain.CompareAbsentLast(compchain.Start(), abs, one, cmpInt).Result())
+	Greater(t, 0, compchain.CompareAbsentLast(compchain.Start(), one, abs, cmpInt).Result())
+	Greater(t, 0, compchain.CompareAbsentLast(compchain.Start(), one, two, cmpInt).Result())
+}
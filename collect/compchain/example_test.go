@@ -15,8 +15,10 @@
 package compchain_test
 
 import (
+	"cmp"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/abc-inc/goava/collect/compchain"
 )
@@ -50,3 +52,34 @@ func Example() {
 	// false
 	// true
 }
+
+func ExampleCompare() {
+	type event struct {
+		Name string
+		At   time.Time
+		Prio *int
+	}
+
+	one := 1
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []event{
+		{"deploy", t0, nil},
+		{"build", t0, &one},
+		{"test", t0.Add(-time.Hour), nil},
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		c := compchain.CompareTime(compchain.Start(), events[i].At, events[j].At)
+		c = compchain.CompareNilsLast(c, events[i].Prio, events[j].Prio, cmp.Compare[int])
+		c = compchain.Compare(c, events[i].Name, events[j].Name)
+		return c.Result() < 0
+	})
+
+	for _, e := range events {
+		fmt.Println(e.Name)
+	}
+	// Output:
+	// test
+	// build
+	// deploy
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"math/big"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+var bigOne = big.NewInt(1)
+
+// BigInt is the discrete domain for values of type *big.Int.
+//
+// The domain is unbounded, i.e., it does not have a minimum or maximum value, and Next and Previous never fail.
+// None of the functions modifies its arguments.
+type BigInt struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d BigInt) Offset(origin *big.Int, distance uint64) (*big.Int, error) {
+	return new(big.Int).Add(origin, new(big.Int).SetUint64(distance)), nil
+}
+
+// Next returns the unique least value of type *big.Int that is greater than value, i.e., v+1.
+//
+// Inverse operation to Previous.
+func (d BigInt) Next(v *big.Int) (*big.Int, error) {
+	return new(big.Int).Add(v, bigOne), nil
+}
+
+// Previous returns the unique greatest value of type *big.Int that is less than value, i.e., v-1.
+//
+// Inverse operation to Next.
+func (d BigInt) Previous(v *big.Int) (*big.Int, error) {
+	return new(big.Int).Sub(v, bigOne), nil
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// An error is returned if the distance exceeds the range of int64.
+func (d BigInt) Distance(start, end *big.Int) (int64, error) {
+	r := new(big.Int).Sub(end, start)
+	if err := precond.CheckStatef(r.IsInt64() || r.Sign() < 0, "big.Int overflow"); err != nil {
+		return 0, err
+	}
+	if err := precond.CheckStatef(r.IsInt64(), "big.Int underflow"); err != nil {
+		return 0, err
+	}
+	return r.Int64(), nil
+}
+
+// String returns a string representation of this domain.
+func (d BigInt) String() string {
+	return "domain.BigInt"
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/abc-inc/goava/collect/domain"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestBigInt_Offset(t *testing.T) {
+	d := domain.BigInt{}
+	origin := big.NewInt(math.MaxInt64)
+
+	v, err := d.Offset(origin, math.MaxUint64)
+	NoError(t, err)
+	Equal(t, "27670116110564327422", v.String())
+	Equal(t, big.NewInt(math.MaxInt64), origin)
+}
+
+func TestBigInt_Next(t *testing.T) {
+	d := domain.BigInt{}
+
+	v, err := d.Next(big.NewInt(math.MaxInt64))
+	NoError(t, err)
+	Equal(t, "9223372036854775808", v.String())
+}
+
+func TestBigInt_Previous(t *testing.T) {
+	d := domain.BigInt{}
+
+	v, err := d.Previous(big.NewInt(math.MinInt64))
+	NoError(t, err)
+	Equal(t, "-9223372036854775809", v.String())
+}
+
+func TestBigInt_Distance(t *testing.T) {
+	d := domain.BigInt{}
+
+	v, err := d.Distance(big.NewInt(-1), big.NewInt(math.MaxInt64-1))
+	NoError(t, err)
+	Equal(t, int64(math.MaxInt64), v)
+
+	_, err = d.Distance(big.NewInt(-2), big.NewInt(math.MaxInt64-1))
+	EqualError(t, err, "big.Int overflow")
+
+	v, err = d.Distance(big.NewInt(0), big.NewInt(math.MinInt64))
+	NoError(t, err)
+	Equal(t, int64(math.MinInt64), v)
+
+	_, err = d.Distance(big.NewInt(1), big.NewInt(math.MinInt64))
+	EqualError(t, err, "big.Int underflow")
+}
+
+func TestBigInt_String(t *testing.T) {
+	Equal(t, "domain.BigInt", domain.BigInt{}.String())
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "time"
+
+// Days is the discrete domain of calendar days, whose values are of type time.Time.
+//
+// Each value represents the calendar day it falls on in its own location. Next and Previous return midnight UTC of the
+// adjacent calendar day, so that they are inverse operations regardless of daylight saving time transitions, whereas
+// Distance only considers the calendar dates and ignores the time of day and location.
+type Days struct {
+}
+
+// Next returns midnight UTC of the calendar day after the one v falls on.
+//
+// Inverse operation to Previous.
+func (d Days) Next(v time.Time) (time.Time, error) {
+	return utcDate(v).AddDate(0, 0, 1), nil
+}
+
+// Previous returns midnight UTC of the calendar day before the one v falls on.
+//
+// Inverse operation to Next.
+func (d Days) Previous(v time.Time) (time.Time, error) {
+	return utcDate(v).AddDate(0, 0, -1), nil
+}
+
+// Distance returns the number of calendar days from start to end, e.g., 1 from 2020-02-28T23:59 to 2020-02-29T00:00.
+func (d Days) Distance(start, end time.Time) (int64, error) {
+	return epochDay(end) - epochDay(start), nil
+}
+
+// String returns a string representation of this domain.
+func (d Days) String() string {
+	return "domain.Days"
+}
+
+// epochDay returns the number of days since 1970-01-01 of the calendar date of t in its location.
+func epochDay(t time.Time) int64 {
+	// midnight UTC is always a multiple of a day, hence the division is exact
+	return utcDate(t).Unix() / (24 * 60 * 60)
+}
+
+// utcDate returns midnight UTC of the calendar date of t in its location.
+func utcDate(t time.Time) time.Time {
+	y, m, day := t.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+}
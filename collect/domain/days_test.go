@@ -0,0 +1,62 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/collect/domain"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestDays(t *testing.T) {
+	d := domain.Days{}
+	loc, err := time.LoadLocation("Europe/Vienna")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// daylight saving time starts on 2020-03-29
+	start := time.Date(2020, 2, 28, 12, 30, 0, 0, loc)
+	v, err := d.Next(start)
+	NoError(t, err)
+	Equal(t, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), v)
+	v, err = d.Previous(v)
+	NoError(t, err)
+	Equal(t, time.Date(2020, 2, 28, 0, 0, 0, 0, time.UTC), v)
+
+	// 02:30 does not exist on 2020-03-29 in Vienna, but Next and Previous are still inverse operations
+	for day := time.Date(2020, 3, 28, 2, 30, 0, 0, loc); day.Month() == 3; day = day.Add(time.Hour) {
+		next, _ := d.Next(day)
+		prev, _ := d.Previous(next)
+		Equal(t, day.Format(time.DateOnly), prev.Format(time.DateOnly))
+		back, _ := d.Next(prev)
+		Equal(t, next, back)
+		Equal(t, int64(1), fst64(d.Distance(day, next)))
+	}
+
+	dist, err := d.Distance(start, time.Date(2020, 3, 30, 0, 0, 0, 0, loc))
+	NoError(t, err)
+	Equal(t, int64(31), dist)
+	dist, err = d.Distance(time.Date(2020, 3, 30, 0, 0, 0, 0, loc), start)
+	NoError(t, err)
+	Equal(t, int64(-31), dist)
+	dist, err = d.Distance(start, time.Date(2020, 2, 28, 23, 59, 59, 0, loc))
+	NoError(t, err)
+	Equal(t, int64(0), dist)
+
+	Equal(t, "domain.Days", d.String())
+}
@@ -19,4 +19,12 @@
 //
 // A discrete domain always represents the entire set of values of its type; it cannot represent partial domains such as
 // "prime integers" or "strings of length 5."
+//
+// All domains implement the generic DiscreteDomain interface, which allows for writing functions that work with any
+// discrete domain. Domains with a minimum and a maximum value, like all fixed-size integers, implement
+// BoundedDiscreteDomain as well.
+//
+// BigInt, Days and Minutes describe types, which are not ordered by the < operator. Hence, they can be used with any
+// function written against DiscreteDomain, but not with the range types in package ranges, which document how to
+// express such ranges with integer endpoints instead.
 package domain
@@ -0,0 +1,75 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// DiscreteDomain is a descriptor for a discrete domain of values of type T, such as all int values.
+//
+// Implementations must be immutable and side-effect-free, e.g., Int, Uint8, Rune, BigInt or Days.
+type DiscreteDomain[T any] interface {
+	fmt.Stringer
+
+	// Next returns the unique least value of type T that is greater than value, or an error if none exists.
+	//
+	// Inverse operation to Previous.
+	Next(v T) (T, error)
+
+	// Previous returns the unique greatest value of type T that is less than value, or an error if none exists.
+	//
+	// Inverse operation to Next.
+	Previous(v T) (T, error)
+
+	// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+	// (if negative) are needed to reach end starting from start.
+	//
+	// An error is returned if the distance does not fit into an int64.
+	Distance(start, end T) (int64, error)
+}
+
+// BoundedDiscreteDomain is a DiscreteDomain, which has a minimum and a maximum value.
+//
+// All domains of fixed-size integers are bounded, whereas BigInt is not.
+type BoundedDiscreteDomain[T any] interface {
+	DiscreteDomain[T]
+
+	// MinValue returns the minimum value of type T.
+	MinValue() T
+
+	// MaxValue returns the maximum value of type T.
+	MaxValue() T
+}
+
+var (
+	_ BoundedDiscreteDomain[int]     = Int{}
+	_ BoundedDiscreteDomain[int8]    = Int8{}
+	_ BoundedDiscreteDomain[int16]   = Int16{}
+	_ BoundedDiscreteDomain[int32]   = Int32{}
+	_ BoundedDiscreteDomain[int64]   = Int64{}
+	_ BoundedDiscreteDomain[uint]    = Uint{}
+	_ BoundedDiscreteDomain[uint8]   = Uint8{}
+	_ BoundedDiscreteDomain[uint16]  = Uint16{}
+	_ BoundedDiscreteDomain[uint32]  = Uint32{}
+	_ BoundedDiscreteDomain[uint64]  = Uint64{}
+	_ BoundedDiscreteDomain[uintptr] = Uintptr{}
+	_ BoundedDiscreteDomain[rune]    = Rune{}
+	_ DiscreteDomain[*big.Int]       = BigInt{}
+	_ DiscreteDomain[time.Time]      = Days{}
+	_ DiscreteDomain[time.Time]      = Minutes{}
+)
@@ -41,20 +41,14 @@ func (d Int) Offset(origin int, distance uint64) (int64, error) {
 //
 // Inverse operation to Previous.
 func (d Int) Next(v int) (int, error) {
-	if err := precond.CheckStatef(v < d.MaxValue(), "int overflow"); err != nil {
-		return 0, err
-	}
-	return v + 1, nil
+	return next(v, d.MaxValue(), "int")
 }
 
 // Previous returns the unique greatest value of type int that is less than value, or an error if none exists.
 //
 // Inverse operation to Next.
 func (d Int) Previous(v int) (int, error) {
-	if err := precond.CheckStatef(v > d.MinValue(), "int underflow"); err != nil {
-		return 0, err
-	}
-	return v - 1, nil
+	return previous(v, d.MinValue(), "int")
 }
 
 // Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
@@ -63,14 +57,7 @@ func (d Int) Previous(v int) (int, error) {
 // For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
 // As well, Distance(a, a) is always zero.
 func (d Int) Distance(start, end int) (int64, error) {
-	r := int64(end) - int64(start)
-	if err := precond.CheckStatef(end <= start || r >= 0, "int overflow"); err != nil {
-		return 0, err
-	}
-	if err := precond.CheckStatef(end >= start || r <= 0, "int underflow"); err != nil {
-		return 0, err
-	}
-	return r, nil
+	return distance(start, end, "int")
 }
 
 // MinValue returns the minimum value of type int.
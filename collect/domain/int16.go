@@ -0,0 +1,68 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "math"
+
+// Int16 is the discrete domain for values of type int16.
+type Int16 struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Int16) Offset(origin int16, distance uint64) (int16, error) {
+	return offset(origin, distance, d.MaxValue(), "int16")
+}
+
+// Next returns the unique least value of type int16 that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Int16) Next(v int16) (int16, error) {
+	return next(v, d.MaxValue(), "int16")
+}
+
+// Previous returns the unique greatest value of type int16 that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Int16) Previous(v int16) (int16, error) {
+	return previous(v, d.MinValue(), "int16")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+func (d Int16) Distance(start, end int16) (int64, error) {
+	return distance(start, end, "int16")
+}
+
+// MinValue returns the minimum value of type int16.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type int16.
+func (d Int16) MinValue() int16 {
+	return math.MinInt16
+}
+
+// MaxValue returns the maximum value of type int16.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type int16.
+func (d Int16) MaxValue() int16 {
+	return math.MaxInt16
+}
+
+// String returns a string representation of this domain.
+func (d Int16) String() string {
+	return "domain.Int16"
+}
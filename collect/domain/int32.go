@@ -43,20 +43,14 @@ func (d Int32) Offset(origin int32, distance uint64) (int32, error) {
 //
 // Inverse operation to Previous.
 func (d Int32) Next(v int32) (int32, error) {
-	if err := precond.CheckStatef(v < d.MaxValue(), "int32 overflow"); err != nil {
-		return 0, err
-	}
-	return v + 1, nil
+	return next(v, d.MaxValue(), "int32")
 }
 
 // Previous returns the unique greatest value of type int32 that is less than value, or an error if none exists.
 //
 // Inverse operation to Next.
 func (d Int32) Previous(v int32) (int32, error) {
-	if err := precond.CheckStatef(v > d.MinValue(), "int32 underflow"); err != nil {
-		return 0, err
-	}
-	return v - 1, nil
+	return previous(v, d.MinValue(), "int32")
 }
 
 // Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
@@ -65,7 +59,7 @@ func (d Int32) Previous(v int32) (int32, error) {
 // For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
 // As well, Distance(a, a) is always zero.
 func (d Int32) Distance(start, end int32) (int64, error) {
-	return int64(end) - int64(start), nil
+	return distance(start, end, "int32")
 }
 
 // MinValue returns the minimum value of type int32.
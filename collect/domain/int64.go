@@ -42,20 +42,14 @@ func (d Int64) Offset(origin int64, distance uint64) (int64, error) {
 //
 // Inverse operation to Previous.
 func (d Int64) Next(v int64) (int64, error) {
-	if err := precond.CheckStatef(v < d.MaxValue(), "int64 overflow"); err != nil {
-		return 0, err
-	}
-	return v + 1, nil
+	return next(v, d.MaxValue(), "int64")
 }
 
 // Previous returns the unique greatest value of type int64 that is less than value, or an error if none exists.
 //
 // Inverse operation to Next.
 func (d Int64) Previous(v int64) (int64, error) {
-	if err := precond.CheckStatef(v > d.MinValue(), "int64 underflow"); err != nil {
-		return 0, err
-	}
-	return v - 1, nil
+	return previous(v, d.MinValue(), "int64")
 }
 
 // Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
@@ -64,14 +58,7 @@ func (d Int64) Previous(v int64) (int64, error) {
 // For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
 // As well, Distance(a, a) is always zero.
 func (d Int64) Distance(start, end int64) (int64, error) {
-	r := end - start
-	if err := precond.CheckStatef(end <= start || r >= 0, "int64 overflow"); err != nil {
-		return 0, err
-	}
-	if err := precond.CheckStatef(end >= start || r <= 0, "int64 underflow"); err != nil {
-		return 0, err
-	}
-	return r, nil
+	return distance(start, end, "int64")
 }
 
 // MinValue returns the minimum value of type int64.
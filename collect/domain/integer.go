@@ -0,0 +1,71 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"math"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// integer is a constraint that permits any integer type.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// next returns v+1, or an error if v is the maximum value.
+func next[T integer](v, maxValue T, name string) (T, error) {
+	if err := precond.CheckStatef(v < maxValue, "%s overflow", name); err != nil {
+		return 0, err
+	}
+	return v + 1, nil
+}
+
+// previous returns v-1, or an error if v is the minimum value.
+func previous[T integer](v, minValue T, name string) (T, error) {
+	if err := precond.CheckStatef(v > minValue, "%s underflow", name); err != nil {
+		return 0, err
+	}
+	return v - 1, nil
+}
+
+// offset returns origin+distance, or an error if the result is greater than the maximum value.
+//
+// The calculation is done in uint64, which is wide enough to hold the difference between any two integers, because
+// converting a negative value to uint64 preserves its two's complement representation.
+func offset[T integer](origin T, distance uint64, maxValue T, name string) (T, error) {
+	if err := precond.CheckStatef(distance <= uint64(maxValue)-uint64(origin), "%s overflow", name); err != nil {
+		return 0, err
+	}
+	return T(uint64(origin) + distance), nil
+}
+
+// distance returns end-start, or an error if the result does not fit into an int64.
+func distance[T integer](start, end T, name string) (int64, error) {
+	if end >= start {
+		d := uint64(end) - uint64(start)
+		if err := precond.CheckStatef(d <= math.MaxInt64, "%s overflow", name); err != nil {
+			return 0, err
+		}
+		return int64(d), nil
+	}
+
+	d := uint64(start) - uint64(end)
+	if err := precond.CheckStatef(d <= -math.MinInt64, "%s underflow", name); err != nil {
+		return 0, err
+	}
+	// for d == -math.MinInt64, int64(d) overflows to math.MinInt64 and its negation is math.MinInt64 again
+	return -int64(d), nil
+}
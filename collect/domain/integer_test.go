@@ -0,0 +1,133 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"math"
+	"testing"
+	"unicode"
+
+	"github.com/abc-inc/goava/collect/domain"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestInt8(t *testing.T) {
+	testBounded[int8](t, domain.Int8{}, "int8", math.MinInt8, math.MaxInt8)
+}
+
+func TestInt16(t *testing.T) {
+	testBounded[int16](t, domain.Int16{}, "int16", math.MinInt16, math.MaxInt16)
+}
+
+func TestUint(t *testing.T) {
+	testBounded[uint](t, domain.Uint{}, "uint", 0, math.MaxUint)
+}
+
+func TestUint8(t *testing.T) {
+	testBounded[uint8](t, domain.Uint8{}, "uint8", 0, math.MaxUint8)
+}
+
+func TestUint16(t *testing.T) {
+	testBounded[uint16](t, domain.Uint16{}, "uint16", 0, math.MaxUint16)
+}
+
+func TestUint32(t *testing.T) {
+	testBounded[uint32](t, domain.Uint32{}, "uint32", 0, math.MaxUint32)
+}
+
+func TestUint64(t *testing.T) {
+	testBounded[uint64](t, domain.Uint64{}, "uint64", 0, math.MaxUint64)
+}
+
+func TestUintptr(t *testing.T) {
+	testBounded[uintptr](t, domain.Uintptr{}, "uintptr", 0, ^uintptr(0))
+}
+
+func TestRune(t *testing.T) {
+	testBounded[rune](t, domain.Rune{}, "rune", 0, unicode.MaxRune)
+}
+
+func TestUint64_Distance(t *testing.T) {
+	d := domain.Uint64{}
+
+	v, err := d.Distance(0, math.MaxInt64)
+	NoError(t, err)
+	Equal(t, int64(math.MaxInt64), v)
+
+	_, err = d.Distance(0, math.MaxInt64+1)
+	EqualError(t, err, "uint64 overflow")
+
+	v, err = d.Distance(math.MaxInt64+1, 0)
+	NoError(t, err)
+	Equal(t, int64(math.MinInt64), v)
+
+	_, err = d.Distance(math.MaxInt64+2, 0)
+	EqualError(t, err, "uint64 underflow")
+}
+
+func TestUint64_Offset(t *testing.T) {
+	d := domain.Uint64{}
+
+	v, err := d.Offset(1, math.MaxUint64-1)
+	NoError(t, err)
+	Equal(t, uint64(math.MaxUint64), v)
+
+	_, err = d.Offset(2, math.MaxUint64-1)
+	EqualError(t, err, "uint64 overflow")
+}
+
+type integer interface {
+	~int8 | ~int16 | ~int32 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type offsetter[T any] interface {
+	Offset(origin T, distance uint64) (T, error)
+}
+
+func testBounded[T integer](t *testing.T, d domain.BoundedDiscreteDomain[T], name string, minValue, maxValue T) {
+	Equal(t, minValue, d.MinValue())
+	Equal(t, maxValue, d.MaxValue())
+
+	v, err := d.Next(d.MinValue())
+	NoError(t, err)
+	Equal(t, minValue+1, v)
+	_, err = d.Next(d.MaxValue())
+	EqualError(t, err, name+" overflow")
+
+	v, err = d.Previous(d.MaxValue())
+	NoError(t, err)
+	Equal(t, maxValue-1, v)
+	_, err = d.Previous(d.MinValue())
+	EqualError(t, err, name+" underflow")
+
+	dist, err := d.Distance(minValue+1, minValue+4)
+	NoError(t, err)
+	Equal(t, int64(3), dist)
+	dist, err = d.Distance(minValue+4, minValue+1)
+	NoError(t, err)
+	Equal(t, int64(-3), dist)
+
+	o := d.(offsetter[T])
+	v, err = o.Offset(minValue, 3)
+	NoError(t, err)
+	Equal(t, minValue+3, v)
+	v, err = o.Offset(maxValue, 0)
+	NoError(t, err)
+	Equal(t, maxValue, v)
+	_, err = o.Offset(maxValue, 1)
+	EqualError(t, err, name+" overflow")
+
+	Equal(t, "domain."+string(name[0]-'a'+'A')+name[1:], d.String())
+}
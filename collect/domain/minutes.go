@@ -0,0 +1,58 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "time"
+
+// Minutes is the discrete domain of minutes, whose values are of type time.Time.
+//
+// The domain is intended for values, which are truncated to full minutes. Next and Previous add or subtract a minute,
+// respectively, whereas Distance counts the minute boundaries between start and end, i.e., seconds are ignored.
+type Minutes struct {
+}
+
+// Next returns v plus one minute.
+//
+// Inverse operation to Previous.
+func (d Minutes) Next(v time.Time) (time.Time, error) {
+	return v.Add(time.Minute), nil
+}
+
+// Previous returns v minus one minute.
+//
+// Inverse operation to Next.
+func (d Minutes) Previous(v time.Time) (time.Time, error) {
+	return v.Add(-time.Minute), nil
+}
+
+// Distance returns the number of minutes from start to end, e.g., 1 from 10:00:59 to 10:01:00.
+func (d Minutes) Distance(start, end time.Time) (int64, error) {
+	return epochMinute(end) - epochMinute(start), nil
+}
+
+// String returns a string representation of this domain.
+func (d Minutes) String() string {
+	return "domain.Minutes"
+}
+
+// epochMinute returns the number of minutes since 1970-01-01T00:00:00Z, rounded towards negative infinity.
+func epochMinute(t time.Time) int64 {
+	s := t.Unix()
+	m := s / 60
+	if s%60 < 0 {
+		m--
+	}
+	return m
+}
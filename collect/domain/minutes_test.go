@@ -0,0 +1,47 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/collect/domain"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestMinutes(t *testing.T) {
+	d := domain.Minutes{}
+	start := time.Date(1969, 12, 31, 23, 59, 0, 0, time.UTC)
+
+	v, err := d.Next(start)
+	NoError(t, err)
+	Equal(t, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), v)
+	v, err = d.Previous(v)
+	NoError(t, err)
+	Equal(t, start, v)
+
+	dist, err := d.Distance(start, time.Date(1970, 1, 1, 1, 0, 0, 0, time.UTC))
+	NoError(t, err)
+	Equal(t, int64(61), dist)
+	dist, err = d.Distance(start.Add(59*time.Second), start.Add(60*time.Second))
+	NoError(t, err)
+	Equal(t, int64(1), dist)
+	dist, err = d.Distance(start.Add(60*time.Second), start)
+	NoError(t, err)
+	Equal(t, int64(-1), dist)
+
+	Equal(t, "domain.Minutes", d.String())
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "unicode"
+
+// Rune is the discrete domain for Unicode code points, i.e., values of type rune from 0 to unicode.MaxRune.
+//
+// Note that the domain contains all code points including surrogate halves, which are not valid runes on their own.
+type Rune struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Rune) Offset(origin rune, distance uint64) (rune, error) {
+	return offset(origin, distance, d.MaxValue(), "rune")
+}
+
+// Next returns the unique least code point that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Rune) Next(v rune) (rune, error) {
+	return next(v, d.MaxValue(), "rune")
+}
+
+// Previous returns the unique greatest code point that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Rune) Previous(v rune) (rune, error) {
+	return previous(v, d.MinValue(), "rune")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+func (d Rune) Distance(start, end rune) (int64, error) {
+	return distance(start, end, "rune")
+}
+
+// MinValue returns the minimum code point, which is 0.
+func (d Rune) MinValue() rune {
+	return 0
+}
+
+// MaxValue returns the maximum code point, which is unicode.MaxRune.
+func (d Rune) MaxValue() rune {
+	return unicode.MaxRune
+}
+
+// String returns a string representation of this domain.
+func (d Rune) String() string {
+	return "domain.Rune"
+}
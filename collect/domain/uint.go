@@ -0,0 +1,70 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "math"
+
+// Uint is the discrete domain for values of type uint.
+type Uint struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Uint) Offset(origin uint, distance uint64) (uint, error) {
+	return offset(origin, distance, d.MaxValue(), "uint")
+}
+
+// Next returns the unique least value of type uint that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Uint) Next(v uint) (uint, error) {
+	return next(v, d.MaxValue(), "uint")
+}
+
+// Previous returns the unique greatest value of type uint that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Uint) Previous(v uint) (uint, error) {
+	return previous(v, d.MinValue(), "uint")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+//
+// An error is returned if the distance exceeds the range of int64.
+func (d Uint) Distance(start, end uint) (int64, error) {
+	return distance(start, end, "uint")
+}
+
+// MinValue returns the minimum value of type uint.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type uint.
+func (d Uint) MinValue() uint {
+	return 0
+}
+
+// MaxValue returns the maximum value of type uint.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type uint.
+func (d Uint) MaxValue() uint {
+	return math.MaxUint
+}
+
+// String returns a string representation of this domain.
+func (d Uint) String() string {
+	return "domain.Uint"
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "math"
+
+// Uint32 is the discrete domain for values of type uint32.
+type Uint32 struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Uint32) Offset(origin uint32, distance uint64) (uint32, error) {
+	return offset(origin, distance, d.MaxValue(), "uint32")
+}
+
+// Next returns the unique least value of type uint32 that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Uint32) Next(v uint32) (uint32, error) {
+	return next(v, d.MaxValue(), "uint32")
+}
+
+// Previous returns the unique greatest value of type uint32 that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Uint32) Previous(v uint32) (uint32, error) {
+	return previous(v, d.MinValue(), "uint32")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+func (d Uint32) Distance(start, end uint32) (int64, error) {
+	return distance(start, end, "uint32")
+}
+
+// MinValue returns the minimum value of type uint32.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type uint32.
+func (d Uint32) MinValue() uint32 {
+	return 0
+}
+
+// MaxValue returns the maximum value of type uint32.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type uint32.
+func (d Uint32) MaxValue() uint32 {
+	return math.MaxUint32
+}
+
+// String returns a string representation of this domain.
+func (d Uint32) String() string {
+	return "domain.Uint32"
+}
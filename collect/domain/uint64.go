@@ -0,0 +1,70 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "math"
+
+// Uint64 is the discrete domain for values of type uint64.
+type Uint64 struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Uint64) Offset(origin uint64, distance uint64) (uint64, error) {
+	return offset(origin, distance, d.MaxValue(), "uint64")
+}
+
+// Next returns the unique least value of type uint64 that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Uint64) Next(v uint64) (uint64, error) {
+	return next(v, d.MaxValue(), "uint64")
+}
+
+// Previous returns the unique greatest value of type uint64 that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Uint64) Previous(v uint64) (uint64, error) {
+	return previous(v, d.MinValue(), "uint64")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+//
+// An error is returned if the distance exceeds the range of int64.
+func (d Uint64) Distance(start, end uint64) (int64, error) {
+	return distance(start, end, "uint64")
+}
+
+// MinValue returns the minimum value of type uint64.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type uint64.
+func (d Uint64) MinValue() uint64 {
+	return 0
+}
+
+// MaxValue returns the maximum value of type uint64.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type uint64.
+func (d Uint64) MaxValue() uint64 {
+	return math.MaxUint64
+}
+
+// String returns a string representation of this domain.
+func (d Uint64) String() string {
+	return "domain.Uint64"
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import "math"
+
+// Uint8 is the discrete domain for values of type uint8.
+type Uint8 struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Uint8) Offset(origin uint8, distance uint64) (uint8, error) {
+	return offset(origin, distance, d.MaxValue(), "uint8")
+}
+
+// Next returns the unique least value of type uint8 that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Uint8) Next(v uint8) (uint8, error) {
+	return next(v, d.MaxValue(), "uint8")
+}
+
+// Previous returns the unique greatest value of type uint8 that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Uint8) Previous(v uint8) (uint8, error) {
+	return previous(v, d.MinValue(), "uint8")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+func (d Uint8) Distance(start, end uint8) (int64, error) {
+	return distance(start, end, "uint8")
+}
+
+// MinValue returns the minimum value of type uint8.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type uint8.
+func (d Uint8) MinValue() uint8 {
+	return 0
+}
+
+// MaxValue returns the maximum value of type uint8.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type uint8.
+func (d Uint8) MaxValue() uint8 {
+	return math.MaxUint8
+}
+
+// String returns a string representation of this domain.
+func (d Uint8) String() string {
+	return "domain.Uint8"
+}
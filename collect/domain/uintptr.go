@@ -0,0 +1,68 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+// Uintptr is the discrete domain for values of type uintptr.
+type Uintptr struct {
+}
+
+// Offset returns, conceptually, "origin + distance", or equivalently, the result of calling Next origin distance times.
+func (d Uintptr) Offset(origin uintptr, distance uint64) (uintptr, error) {
+	return offset(origin, distance, d.MaxValue(), "uintptr")
+}
+
+// Next returns the unique least value of type uintptr that is greater than value, or an error if none exists.
+//
+// Inverse operation to Previous.
+func (d Uintptr) Next(v uintptr) (uintptr, error) {
+	return next(v, d.MaxValue(), "uintptr")
+}
+
+// Previous returns the unique greatest value of type uintptr that is less than value, or an error if none exists.
+//
+// Inverse operation to Next.
+func (d Uintptr) Previous(v uintptr) (uintptr, error) {
+	return previous(v, d.MinValue(), "uintptr")
+}
+
+// Distance returns a signed value indicating how many nested invocations of Next (if positive) or Previous
+// (if negative) are needed to reach end starting from start.
+//
+// For example, if end = Next(Next(Next(start))), then Distance(start, end) == 3 and Distance(end, start) == -3.
+// As well, Distance(a, a) is always zero.
+//
+// An error is returned if the distance exceeds the range of int64.
+func (d Uintptr) Distance(start, end uintptr) (int64, error) {
+	return distance(start, end, "uintptr")
+}
+
+// MinValue returns the minimum value of type uintptr.
+//
+// The minimum value m is the unique value for which o<m never returns a true for any value o of type uintptr.
+func (d Uintptr) MinValue() uintptr {
+	return 0
+}
+
+// MaxValue returns the maximum value of type uintptr.
+//
+// The maximum value m is the unique value for which o>m never returns a true for any value o of type uintptr.
+func (d Uintptr) MaxValue() uintptr {
+	return ^uintptr(0)
+}
+
+// String returns a string representation of this domain.
+func (d Uintptr) String() string {
+	return "domain.Uintptr"
+}
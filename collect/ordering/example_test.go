@@ -0,0 +1,61 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering_test
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/collect/ordering"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func Example() {
+	users := []user{{"Jane", 42}, {"John", 23}, {"Jack", 42}}
+
+	byAge := ordering.OnResultOf(func(u user) int { return u.Age }, ordering.Natural[int]())
+	byName := ordering.OnResultOf(func(u user) string { return u.Name }, ordering.Natural[string]())
+	o := byAge.Reverse().Compound(byName)
+
+	fmt.Println(o.SortedCopy(users))
+	fmt.Println(byAge.GreatestOf(users, 1))
+	// Output:
+	// [{Jack 42} {Jane 42} {John 23}]
+	// [{Jane 42}] <nil>
+}
+
+func ExampleByTags() {
+	type account struct {
+		Owner   string `goava:"order=2"`
+		Balance int    `goava:"order=1,desc"`
+	}
+
+	o, _ := ordering.ByTags[account]()
+	fmt.Println(o.SortedCopy([]account{{"Jane", 10}, {"John", 20}, {"Jack", 10}}))
+	// Output:
+	// [{John 20} {Jack 10} {Jane 10}]
+}
+
+func ExampleByFields() {
+	users := []user{{"Jane", 42}, {"John", 23}, {"Jack", 42}}
+
+	o, _ := ordering.ByFields[user]("Age,desc", "Name")
+	fmt.Println(o.SortedCopy(users))
+	// Output:
+	// [{Jack 42} {Jane 42} {John 23}]
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// IncomparableValueError indicates that an explicit ordering was asked to compare a value, which was not among the
+// values it was created with.
+type IncomparableValueError struct {
+	value interface{}
+}
+
+func (e *IncomparableValueError) Error() string {
+	return fmt.Sprintf("cannot compare value: %v", e.value)
+}
+
+// Explicit returns an Ordering, which compares values according to the order in which they are given.
+//
+// For example, Explicit("red", "green", "blue") considers "red" < "green" < "blue".
+// An error is returned if the values contain duplicates.
+//
+// The returned Ordering panics with an *IncomparableValueError when comparing a value, which was not given.
+func Explicit[T comparable](vs ...T) (Ordering[T], error) {
+	rank := make(map[T]int, len(vs))
+	for i, v := range vs {
+		_, dup := rank[v]
+		if err := precond.CheckArgumentf(!dup, "duplicate value: %v", v); err != nil {
+			return nil, err
+		}
+		rank[v] = i
+	}
+
+	rankOf := func(v T) int {
+		r, ok := rank[v]
+		if !ok {
+			panic(&IncomparableValueError{v})
+		}
+		return r
+	}
+	return func(a, b T) int {
+		return rankOf(a) - rankOf(b)
+	}, nil
+}
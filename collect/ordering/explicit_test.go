@@ -0,0 +1,33 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering_test
+
+import (
+	"testing"
+
+	"github.com/abc-inc/goava/collect/ordering"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestExplicit(t *testing.T) {
+	o, err := ordering.Explicit("red", "green", "blue")
+	NoError(t, err)
+	Equal(t, []string{"red", "green", "blue"}, o.SortedCopy([]string{"blue", "red", "green"}))
+
+	PanicsWithError(t, "cannot compare value: yellow", func() { o("red", "yellow") })
+
+	_, err = ordering.Explicit(1, 2, 1)
+	EqualError(t, err, "duplicate value: 1")
+}
@@ -0,0 +1,430 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering
+
+import (
+	"cmp"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/abc-inc/goava/base/precond"
+)
+
+// TagName is the name of the struct tag, which is evaluated by ByTags.
+const TagName = "goava"
+
+// valueCmp compares two reflected values of the same type.
+type valueCmp func(a, b reflect.Value) int
+
+// cacheKey identifies a compiled comparator by the type it was compiled for and the field specs it was compiled from,
+// if any.
+type cacheKey struct {
+	typ      reflect.Type
+	byFields bool
+	specs    string
+}
+
+// cache holds the compiled comparators of ByTags and ByFields.
+var cache sync.Map // map[cacheKey]valueCmp
+
+// ByTags returns an Ordering for the struct type T (or a pointer to it), which compares values field by field in the
+// order given by their struct tags.
+//
+// Fields are considered if they carry a tag of the form `goava:"order=N"`, where N determines the position of the
+// field within the comparison. The options "desc" and "nilslast" reverse the order of the field and put nil values
+// last, respectively, e.g.:
+//
+//	type User struct {
+//		Name    string    `goava:"order=1"`
+//		Created time.Time `goava:"order=2,desc"`
+//		Manager *User     `goava:"order=3,nilslast"`
+//	}
+//
+// Supported field types are booleans (false < true), integers, floats, strings, types with a method Compare(T) int
+// (such as time.Time), structs (which are compared by their own tags), as well as slices and arrays thereof (which are
+// compared lexicographically) and pointers to any of them. Unless "nilslast" is specified, nil pointers are considered
+// less than non-nil ones. The option "desc" only reverses the order of non-nil values, i.e., it does not affect
+// whether nil values come first or last.
+//
+// The reflective field access is compiled once per type and cached. An error is returned if T is not a struct, if it
+// has no ordered fields, or if a tag is malformed or applied to an unsupported or unexported field.
+func ByTags[T any]() (Ordering[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	c, err := cached(cacheKey{t, false, ""}, func() (valueCmp, error) {
+		if err := precond.CheckArgumentf(structType(t) != nil, "%s is not a struct", t); err != nil {
+			return nil, err
+		}
+		return compileValue(t, false, false, map[reflect.Type]*valueCmp{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromValueCmp[T](c), nil
+}
+
+// ByFields returns an Ordering for the struct type T (or a pointer to it), which compares values by the given fields.
+//
+// Each spec consists of a field path, optionally followed by the same comma-separated options as in ByTags, e.g.
+// "Address.City,desc,nilslast". A field path is a dot-separated list of field names, which may traverse nested structs
+// and pointers to structs. If a pointer along the path is nil, the field is considered nil.
+// Fields are compared like in ByTags, except that struct tags are only evaluated for nested struct values.
+//
+// The reflective field access is compiled once per type and set of specs and cached.
+func ByFields[T any](specs ...string) (Ordering[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	c, err := cached(cacheKey{t, true, strings.Join(specs, ";")}, func() (valueCmp, error) {
+		st := structType(t)
+		if err := precond.CheckArgumentf(st != nil, "%s is not a struct", t); err != nil {
+			return nil, err
+		}
+		if err := precond.CheckArgumentf(len(specs) > 0, "field specs must not be empty"); err != nil {
+			return nil, err
+		}
+
+		fcs := make([]valueCmp, len(specs))
+		for i, s := range specs {
+			path, opts, _ := strings.Cut(s, ",")
+			desc, nilsLast, err := parseOptions(opts, s)
+			if err != nil {
+				return nil, err
+			}
+			idx, ft, err := lookupPath(st, path)
+			if err != nil {
+				return nil, err
+			}
+			vc, err := compileValue(ft, nilsLast, desc, map[reflect.Type]*valueCmp{})
+			if err != nil {
+				return nil, err
+			}
+			fcs[i] = fieldCmp(idx, vc, nilsLast)
+		}
+		return derefRoot(t, compound(fcs)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromValueCmp[T](c), nil
+}
+
+func cached(k cacheKey, compile func() (valueCmp, error)) (valueCmp, error) {
+	if c, ok := cache.Load(k); ok {
+		return c.(valueCmp), nil
+	}
+	c, err := compile()
+	if err != nil {
+		return nil, err
+	}
+	cache.Store(k, c)
+	return c, nil
+}
+
+func fromValueCmp[T any](c valueCmp) Ordering[T] {
+	return func(a, b T) int {
+		return c(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+	}
+}
+
+// structType returns t, or the type it points to, if it is a struct type, and nil otherwise.
+func structType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// derefRoot adapts c, which compares structs, to the root type t, which is either the struct or a pointer to it.
+func derefRoot(t reflect.Type, c valueCmp) valueCmp {
+	if t.Kind() != reflect.Pointer {
+		return c
+	}
+	return nilsCmp(false, func(a, b reflect.Value) int { return c(a.Elem(), b.Elem()) })
+}
+
+// parseOptions parses the comma-separated options of a tag or field spec.
+func parseOptions(opts, src string) (desc, nilsLast bool, err error) {
+	if opts == "" {
+		return false, false, nil
+	}
+	for _, o := range strings.Split(opts, ",") {
+		switch strings.TrimSpace(o) {
+		case "asc":
+			desc = false
+		case "desc":
+			desc = true
+		case "nilsfirst":
+			nilsLast = false
+		case "nilslast":
+			nilsLast = true
+		default:
+			return false, false, precond.CheckArgumentf(false, "unknown option %q in %q", o, src)
+		}
+	}
+	return desc, nilsLast, nil
+}
+
+// lookupPath resolves a dot-separated field path starting at the struct type st and returns the index sequences of
+// the traversed fields as well as the type of the last one.
+func lookupPath(st reflect.Type, path string) ([][]int, reflect.Type, error) {
+	var idx [][]int
+	t := st
+	for i, name := range strings.Split(path, ".") {
+		if i > 0 {
+			if t = structType(t); t == nil {
+				return nil, nil, precond.CheckArgumentf(false, "cannot resolve %q in %s: not a struct", path, st)
+			}
+		}
+		f, ok := t.FieldByName(name)
+		if err := precond.CheckArgumentf(ok, "cannot resolve %q in %s: no field %s", path, st, name); err != nil {
+			return nil, nil, err
+		}
+		if err := precond.CheckArgumentf(f.IsExported(), "field %s.%s is not exported", t, name); err != nil {
+			return nil, nil, err
+		}
+		idx = append(idx, f.Index)
+		t = f.Type
+	}
+	return idx, t, nil
+}
+
+// compileTags compiles a comparator for the struct type t based on the struct tags of its fields.
+func compileTags(t reflect.Type, visiting map[reflect.Type]*valueCmp) (valueCmp, error) {
+	if c, ok := cache.Load(cacheKey{t, false, ""}); ok {
+		return c.(valueCmp), nil
+	}
+	if p, ok := visiting[t]; ok {
+		// recursive type: defer the lookup until the comparator has been compiled
+		return func(a, b reflect.Value) int { return (*p)(a, b) }, nil
+	}
+	p := new(valueCmp)
+	visiting[t] = p
+
+	type field struct {
+		order int
+		cmp   valueCmp
+	}
+	var fs []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(TagName)
+		if !ok || tag == "-" {
+			continue
+		}
+		if err := precond.CheckArgumentf(f.IsExported(), "field %s.%s is not exported", t, f.Name); err != nil {
+			return nil, err
+		}
+
+		ord, opts, _ := strings.Cut(tag, ",")
+		num, ok := strings.CutPrefix(ord, "order=")
+		n, convErr := strconv.Atoi(num)
+		if err := precond.CheckArgumentf(ok && convErr == nil, "invalid tag %q of field %s.%s", tag, t, f.Name); err != nil {
+			return nil, err
+		}
+		desc, nilsLast, err := parseOptions(opts, tag)
+		if err != nil {
+			return nil, err
+		}
+		vc, err := compileValue(f.Type, nilsLast, desc, visiting)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, field{n, fieldCmp([][]int{{i}}, vc, nilsLast)})
+	}
+	if err := precond.CheckArgumentf(len(fs) > 0, "%s has no ordered fields", t); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(fs, func(i, j int) bool { return fs[i].order < fs[j].order })
+	fcs := make([]valueCmp, len(fs))
+	for i, f := range fs {
+		if i > 0 && f.order == fs[i-1].order {
+			return nil, precond.CheckArgumentf(false, "duplicate order %d in %s", f.order, t)
+		}
+		fcs[i] = f.cmp
+	}
+	*p = compound(fcs)
+	cache.Store(cacheKey{t, false, ""}, *p)
+	return *p, nil
+}
+
+// compileValue compiles a comparator for values of type t.
+// If desc is true, non-nil values are compared in descending order, whereas nil pointers are still ordered according
+// to nilsLast.
+func compileValue(t reflect.Type, nilsLast, desc bool, visiting map[reflect.Type]*valueCmp) (valueCmp, error) {
+	if !hasCompare(t) {
+		switch t.Kind() {
+		case reflect.Pointer:
+			ec, err := compileValue(t.Elem(), nilsLast, desc, visiting)
+			if err != nil {
+				return nil, err
+			}
+			return nilsCmp(nilsLast, func(a, b reflect.Value) int { return ec(a.Elem(), b.Elem()) }), nil
+		case reflect.Slice, reflect.Array:
+			ec, err := compileValue(t.Elem(), nilsLast, desc, visiting)
+			if err != nil {
+				return nil, err
+			}
+			return func(a, b reflect.Value) int {
+				for i := 0; i < a.Len() && i < b.Len(); i++ {
+					if r := ec(a.Index(i), b.Index(i)); r != 0 {
+						return r
+					}
+				}
+				if desc {
+					return cmp.Compare(b.Len(), a.Len())
+				}
+				return cmp.Compare(a.Len(), b.Len())
+			}, nil
+		}
+	}
+
+	c, err := compileOrdered(t, visiting)
+	if err != nil || !desc {
+		return c, err
+	}
+	return func(a, b reflect.Value) int { return c(b, a) }, nil
+}
+
+// hasCompare reports whether t has a method Compare(t) int.
+func hasCompare(t reflect.Type) bool {
+	m, ok := t.MethodByName("Compare")
+	return ok && m.Type.NumIn() == 2 && m.Type.In(1) == t && m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Int
+}
+
+// compileOrdered compiles an ascending comparator for values of type t, which must neither be a pointer nor a slice
+// or array unless it has a Compare method.
+func compileOrdered(t reflect.Type, visiting map[reflect.Type]*valueCmp) (valueCmp, error) {
+	if hasCompare(t) {
+		m, _ := t.MethodByName("Compare")
+		return func(a, b reflect.Value) int {
+			return int(m.Func.Call([]reflect.Value{a, b})[0].Int())
+		}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return func(a, b reflect.Value) int {
+			switch x, y := a.Bool(), b.Bool(); {
+			case x == y:
+				return 0
+			case x:
+				return 1
+			default:
+				return -1
+			}
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Int(), b.Int()) }, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Uint(), b.Uint()) }, nil
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Float(), b.Float()) }, nil
+	case reflect.String:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.String(), b.String()) }, nil
+	case reflect.Struct:
+		return compileTags(t, visiting)
+	default:
+		return nil, precond.CheckArgumentf(false, "cannot compare values of type %s", t)
+	}
+}
+
+// fieldCmp returns a comparator, which resolves the field identified by the index sequences idx and compares the
+// field values using c.
+func fieldCmp(idx [][]int, c valueCmp, nilsLast bool) valueCmp {
+	resolve := func(v reflect.Value) (reflect.Value, bool) {
+		for i, fi := range idx {
+			if i > 0 {
+				if v = derefAll(v); !v.IsValid() {
+					return v, false
+				}
+			}
+			for j, x := range fi {
+				if j > 0 {
+					// embedded pointer to struct
+					if v = derefAll(v); !v.IsValid() {
+						return v, false
+					}
+				}
+				v = v.Field(x)
+			}
+		}
+		return v, true
+	}
+
+	return func(a, b reflect.Value) int {
+		a, aOk := resolve(a)
+		b, bOk := resolve(b)
+		if aOk && bOk {
+			return c(a, b)
+		}
+		return nilResult(!aOk, !bOk, nilsLast)
+	}
+}
+
+// derefAll dereferences v until it is not a pointer anymore and returns the zero Value if a nil pointer is encountered.
+func derefAll(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// nilsCmp wraps c, which compares non-nil pointers, such that nil pointers are ordered first or last.
+func nilsCmp(nilsLast bool, c valueCmp) valueCmp {
+	return func(a, b reflect.Value) int {
+		if aNil, bNil := a.IsNil(), b.IsNil(); aNil || bNil {
+			return nilResult(aNil, bNil, nilsLast)
+		}
+		return c(a, b)
+	}
+}
+
+func nilResult(aNil, bNil, nilsLast bool) int {
+	r := 0
+	switch {
+	case aNil && bNil:
+		return 0
+	case aNil:
+		r = -1
+	default:
+		r = 1
+	}
+	if nilsLast {
+		return -r
+	}
+	return r
+}
+
+func compound(cs []valueCmp) valueCmp {
+	if len(cs) == 1 {
+		return cs[0]
+	}
+	return func(a, b reflect.Value) int {
+		for _, c := range cs {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
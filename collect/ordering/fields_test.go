@@ -0,0 +1,190 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abc-inc/goava/collect/ordering"
+	. "github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string `goava:"order=1"`
+	Zip  *int   `goava:"order=2,nilslast"`
+}
+
+type person struct {
+	Name    string    `goava:"order=2"`
+	Born    time.Time `goava:"order=1,desc"`
+	Addr    *address  `goava:"order=3"`
+	Tags    []string  `goava:"order=4"`
+	Boss    *person   `goava:"order=5"`
+	Ignored int
+	Skipped int `goava:"-"`
+}
+
+func ptr[T any](v T) *T { return &v }
+
+var t0 = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestByTags(t *testing.T) {
+	o := fst(ordering.ByTags[person]())
+	Equal(t, 0, o(person{Name: "a", Ignored: 1}, person{Name: "a", Skipped: 2}))
+	Equal(t, -1, o(person{Name: "a"}, person{Name: "b"}))
+	Equal(t, -1, o(person{Name: "b", Born: t0}, person{Name: "a"}))
+	Equal(t, -1, o(person{Addr: nil}, person{Addr: &address{}}))
+	Equal(t, -1, o(person{Addr: &address{City: "A"}}, person{Addr: &address{City: "B"}}))
+	Equal(t, -1, o(person{Addr: &address{Zip: ptr(1)}}, person{Addr: &address{}}))
+	Equal(t, -1, o(person{Tags: []string{"a"}}, person{Tags: []string{"a", "b"}}))
+	Equal(t, 1, o(person{Boss: &person{Name: "b"}}, person{Boss: &person{Name: "a"}}))
+}
+
+func TestByTags_Pointer(t *testing.T) {
+	o := fst(ordering.ByTags[*address]())
+	vs := []*address{{City: "B"}, nil, {City: "A", Zip: ptr(2)}, {City: "A"}, {City: "A", Zip: ptr(1)}}
+	Equal(t, []*address{nil, {City: "A", Zip: ptr(1)}, {City: "A", Zip: ptr(2)}, {City: "A"}, {City: "B"}},
+		o.SortedCopy(vs))
+}
+
+func TestByTags_DescNils(t *testing.T) {
+	type last struct {
+		P *int `goava:"order=1,desc,nilslast"`
+	}
+	type first struct {
+		P *int `goava:"order=1,nilsfirst,desc"`
+	}
+
+	ol := fst(ordering.ByTags[last]())
+	Equal(t, 1, ol(last{}, last{ptr(1)}))
+	Equal(t, -1, ol(last{ptr(2)}, last{ptr(1)}))
+	Equal(t, []last{{ptr(2)}, {ptr(1)}, {}}, ol.SortedCopy([]last{{ptr(1)}, {}, {ptr(2)}}))
+
+	of := fst(ordering.ByTags[first]())
+	Equal(t, -1, of(first{}, first{ptr(1)}))
+	Equal(t, -1, of(first{ptr(2)}, first{ptr(1)}))
+	Equal(t, []first{{}, {ptr(2)}, {ptr(1)}}, of.SortedCopy([]first{{ptr(1)}, {}, {ptr(2)}}))
+}
+
+func TestByTags_Cached(t *testing.T) {
+	o1 := fst(ordering.ByTags[address]())
+	o2 := fst(ordering.ByTags[address]())
+	Equal(t, o1(address{City: "A"}, address{City: "B"}), o2(address{City: "A"}, address{City: "B"}))
+}
+
+func TestByTags_Invalid(t *testing.T) {
+	_, err := ordering.ByTags[int]()
+	EqualError(t, err, "int is not a struct")
+
+	_, err = ordering.ByTags[struct{ A int }]()
+	EqualError(t, err, "struct { A int } has no ordered fields")
+
+	_, err = ordering.ByTags[struct {
+		A int `goava:"order=1"`
+		B int `goava:"order=1"`
+	}]()
+	EqualError(t, err, "duplicate order 1 in struct { A int \"goava:\\\"order=1\\\"\"; B int \"goava:\\\"order=1\\\"\" }")
+
+	_, err = ordering.ByTags[struct {
+		A int `goava:"first"`
+	}]()
+	ErrorContains(t, err, `invalid tag "first" of field`)
+
+	_, err = ordering.ByTags[struct {
+		A int `goava:"order=1,up"`
+	}]()
+	EqualError(t, err, `unknown option "up" in "order=1,up"`)
+
+	_, err = ordering.ByTags[struct {
+		a int `goava:"order=1"`
+	}]()
+	ErrorContains(t, err, "a is not exported")
+
+	_, err = ordering.ByTags[struct {
+		M map[string]int `goava:"order=1"`
+	}]()
+	EqualError(t, err, "cannot compare values of type map[string]int")
+}
+
+func TestByFields(t *testing.T) {
+	o := fst(ordering.ByFields[person]("Addr.City", "Addr.Zip,desc", "Name"))
+	vs := []person{
+		{Name: "c", Addr: &address{City: "B"}},
+		{Name: "b", Addr: &address{City: "A", Zip: ptr(1)}},
+		{Name: "a"},
+		{Name: "a", Addr: &address{City: "A", Zip: ptr(2)}},
+		{Name: "b"},
+	}
+	Equal(t, []string{"a", "b", "a", "b", "c"}, names(o.SortedCopy(vs)))
+
+	o = fst(ordering.ByFields[person]("Boss.Boss.Name,nilslast", "Name,desc"))
+	vs = []person{
+		{Name: "a"},
+		{Name: "b", Boss: &person{}},
+		{Name: "c", Boss: &person{Boss: &person{Name: "x"}}},
+	}
+	Equal(t, []string{"c", "b", "a"}, names(o.SortedCopy(vs)))
+}
+
+func TestByFields_DescNils(t *testing.T) {
+	vs := []address{{Zip: ptr(1)}, {}, {Zip: ptr(2)}}
+	o := fst(ordering.ByFields[address]("Zip,desc,nilslast"))
+	Equal(t, []address{{Zip: ptr(2)}, {Zip: ptr(1)}, {}}, o.SortedCopy(vs))
+	o = fst(ordering.ByFields[address]("Zip,desc,nilsfirst"))
+	Equal(t, []address{{}, {Zip: ptr(2)}, {Zip: ptr(1)}}, o.SortedCopy(vs))
+
+	ps := []person{{Name: "a"}, {Name: "b", Addr: &address{City: "A"}}, {Name: "c", Addr: &address{City: "B"}}}
+	op := fst(ordering.ByFields[person]("Addr.City,desc,nilslast"))
+	Equal(t, []string{"c", "b", "a"}, names(op.SortedCopy(ps)))
+	op = fst(ordering.ByFields[person]("Addr.City,desc"))
+	Equal(t, []string{"a", "c", "b"}, names(op.SortedCopy(ps)))
+
+	ts := []person{{Name: "a", Tags: []string{"x"}}, {Name: "b", Tags: []string{"x", "y"}}, {Name: "c"}}
+	op = fst(ordering.ByFields[person]("Tags,desc"))
+	Equal(t, []string{"b", "a", "c"}, names(op.SortedCopy(ts)))
+}
+
+func TestByFields_Embedded(t *testing.T) {
+	type employee struct {
+		*person
+		ID int
+	}
+	o := fst(ordering.ByFields[employee]("Name", "ID"))
+	Equal(t, -1, o(employee{nil, 2}, employee{&person{}, 1}))
+	Equal(t, -1, o(employee{&person{}, 1}, employee{&person{}, 2}))
+}
+
+func TestByFields_Invalid(t *testing.T) {
+	_, err := ordering.ByFields[person]()
+	EqualError(t, err, "field specs must not be empty")
+
+	_, err = ordering.ByFields[person]("Addr.Street")
+	EqualError(t, err, `cannot resolve "Addr.Street" in ordering_test.person: no field Street`)
+
+	_, err = ordering.ByFields[person]("Name.First")
+	EqualError(t, err, `cannot resolve "Name.First" in ordering_test.person: not a struct`)
+
+	_, err = ordering.ByFields[person]("Name,up")
+	EqualError(t, err, `unknown option "up" in "Name,up"`)
+}
+
+func names(ps []person) []string {
+	ns := make([]string, len(ps))
+	for i, p := range ps {
+		ns[i] = p.Name
+	}
+	return ns
+}
@@ -0,0 +1,274 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ordering provides a comparator type with fluent methods for building and applying orderings.
+//
+// An Ordering is a plain comparison function func(a, b T) int, which returns a negative value if a is less than b,
+// a positive value if a is greater than b, or zero if both are equal. Hence, it can be passed to functions such as
+// slices.SortFunc as well as collect/compchain.ComparisonChain.CompareFunc (see Ordering.Untyped).
+package ordering
+
+import (
+	"cmp"
+	"sort"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/internal/reflectutil"
+)
+
+// Ordering is a comparison function with fluent methods for deriving new orderings and for applying them to values.
+type Ordering[T any] func(a, b T) int
+
+// Natural returns an Ordering that uses the natural order of the values, as defined by cmp.Compare.
+func Natural[T cmp.Ordered]() Ordering[T] {
+	return cmp.Compare[T]
+}
+
+// From returns an Ordering based on the given comparison function.
+func From[T any](cmp func(a, b T) int) Ordering[T] {
+	return cmp
+}
+
+// FromUntyped returns an Ordering based on a comparison function of interface values, such as the ones accepted by
+// compchain.ComparisonChain.CompareFunc.
+func FromUntyped[T any](cmp func(l, r interface{}) int) Ordering[T] {
+	return func(a, b T) int {
+		return cmp(a, b)
+	}
+}
+
+// OnResultOf returns an Ordering on F, which orders values by first applying f to them, and then comparing the results
+// using o.
+//
+// For example, to order strings by their lengths, use OnResultOf(func(s string) int { return len(s) }, Natural[int]()).
+func OnResultOf[F, T any](f func(F) T, o Ordering[T]) Ordering[F] {
+	return func(a, b F) int {
+		return o(f(a), f(b))
+	}
+}
+
+// Compound returns an Ordering, which tries each given ordering in order until a nonzero result is found, returning
+// that result, and returning zero only if all orderings return zero.
+func Compound[T any](os ...Ordering[T]) Ordering[T] {
+	return func(a, b T) int {
+		for _, o := range os {
+			if r := o(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Lexicographical returns an Ordering, which sorts slices by comparing corresponding elements pairwise until a nonzero
+// result is found; imposes "dictionary order".
+//
+// If the end of one slice is reached, but not the other, the shorter slice is considered to be less than the longer
+// one. For example, a lexicographical natural ordering over int considers [] < [1] < [1, 1] < [1, 2] < [2].
+func Lexicographical[T any](o Ordering[T]) Ordering[[]T] {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if r := o(a[i], b[i]); r != 0 {
+				return r
+			}
+		}
+		return cmp.Compare(len(a), len(b))
+	}
+}
+
+// Compare compares its two arguments for order.
+func (o Ordering[T]) Compare(a, b T) int {
+	return o(a, b)
+}
+
+// Reverse returns the reverse of this ordering.
+func (o Ordering[T]) Reverse() Ordering[T] {
+	return func(a, b T) int {
+		return o(b, a)
+	}
+}
+
+// NilsFirst returns an Ordering, which treats nil as less than all other values and uses this ordering to compare
+// non-nil values.
+//
+// Nil pointers, maps, slices, channels, functions and interfaces are considered nil.
+func (o Ordering[T]) NilsFirst() Ordering[T] {
+	return o.nils(-1)
+}
+
+// NilsLast returns an Ordering, which treats nil as greater than all other values and uses this ordering to compare
+// non-nil values.
+//
+// Nil pointers, maps, slices, channels, functions and interfaces are considered nil.
+func (o Ordering[T]) NilsLast() Ordering[T] {
+	return o.nils(1)
+}
+
+func (o Ordering[T]) nils(nilResult int) Ordering[T] {
+	return func(a, b T) int {
+		aNil, bNil := reflectutil.IsNil(a), reflectutil.IsNil(b)
+		switch {
+		case aNil && bNil:
+			return 0
+		case aNil:
+			return nilResult
+		case bNil:
+			return -nilResult
+		default:
+			return o(a, b)
+		}
+	}
+}
+
+// Compound returns an Ordering, which first uses this ordering, but which in the event of a "tie", then delegates to
+// secondary.
+func (o Ordering[T]) Compound(secondary Ordering[T]) Ordering[T] {
+	return Compound(o, secondary)
+}
+
+// Untyped returns a comparison function of interface values, which can be used in
+// compchain.ComparisonChain.CompareFunc.
+//
+// The returned function panics if it is invoked with values that are not of type T.
+func (o Ordering[T]) Untyped() func(l, r interface{}) int {
+	return func(l, r interface{}) int {
+		return o(l.(T), r.(T))
+	}
+}
+
+// Min returns the least of the specified values according to this ordering.
+// If there are multiple least values, the first of those is returned.
+//
+// An error is returned if no values are given.
+func (o Ordering[T]) Min(vs ...T) (T, error) {
+	var zero T
+	if err := precond.CheckArgumentf(len(vs) > 0, "values must not be empty"); err != nil {
+		return zero, err
+	}
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if o(v, m) < 0 {
+			m = v
+		}
+	}
+	return m, nil
+}
+
+// Max returns the greatest of the specified values according to this ordering.
+// If there are multiple greatest values, the first of those is returned.
+//
+// An error is returned if no values are given.
+func (o Ordering[T]) Max(vs ...T) (T, error) {
+	return o.Reverse().Min(vs...)
+}
+
+// LeastOf returns the k least elements of the given slice according to this ordering, in order from least to greatest.
+// If there are fewer than k elements present, all of them are included.
+//
+// The elements are determined by means of a partial selection, which takes O(n + k log k) time on average.
+// The given slice is not modified.
+func (o Ordering[T]) LeastOf(vs []T, k int) ([]T, error) {
+	if _, err := precond.CheckNonnegative(k, "k"); err != nil {
+		return nil, err
+	}
+	if k >= len(vs) {
+		return o.SortedCopy(vs), nil
+	}
+
+	c := append([]T(nil), vs...)
+	o.selectLeast(c, k)
+	c = c[:k:k]
+	sort.SliceStable(c, func(i, j int) bool { return o(c[i], c[j]) < 0 })
+	return c, nil
+}
+
+// GreatestOf returns the k greatest elements of the given slice according to this ordering, in order from greatest to
+// least. If there are fewer than k elements present, all of them are included.
+//
+// The elements are determined by means of a partial selection, which takes O(n + k log k) time on average.
+// The given slice is not modified.
+func (o Ordering[T]) GreatestOf(vs []T, k int) ([]T, error) {
+	return o.Reverse().LeastOf(vs, k)
+}
+
+// IsOrdered returns true if each element in vs after the first is greater than or equal to the element that preceded
+// it, according to this ordering.
+func (o Ordering[T]) IsOrdered(vs []T) bool {
+	for i := 1; i < len(vs); i++ {
+		if o(vs[i-1], vs[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsStrictlyOrdered returns true if each element in vs after the first is strictly greater than the element that
+// preceded it, according to this ordering.
+func (o Ordering[T]) IsStrictlyOrdered(vs []T) bool {
+	for i := 1; i < len(vs); i++ {
+		if o(vs[i-1], vs[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SortedCopy returns a slice containing the elements of vs sorted by this ordering.
+//
+// The sort is stable, i.e., equal elements retain their relative order. The given slice is not modified.
+func (o Ordering[T]) SortedCopy(vs []T) []T {
+	c := append([]T(nil), vs...)
+	sort.SliceStable(c, func(i, j int) bool { return o(c[i], c[j]) < 0 })
+	return c
+}
+
+// BinarySearch searches the sorted slice for key using the binary search algorithm.
+//
+// It returns the position where key is found, or the position where key would be inserted, and a bool saying whether
+// key was found. The slice must be sorted according to this ordering.
+func (o Ordering[T]) BinarySearch(sorted []T, key T) (int, bool) {
+	i := sort.Search(len(sorted), func(i int) bool { return o(sorted[i], key) >= 0 })
+	return i, i < len(sorted) && o(sorted[i], key) == 0
+}
+
+// selectLeast partially sorts vs so that the k least elements are located in vs[:k], in no particular order.
+func (o Ordering[T]) selectLeast(vs []T, k int) {
+	left, right := 0, len(vs)-1
+	for left < right {
+		p := o.partition(vs, left, right, left+(right-left)/2)
+		switch {
+		case p == k:
+			return
+		case p < k:
+			left = p + 1
+		default:
+			right = p - 1
+		}
+	}
+}
+
+// partition moves all elements less than vs[pivot] to the left of it and returns the new position of the pivot.
+func (o Ordering[T]) partition(vs []T, left, right, pivot int) int {
+	pv := vs[pivot]
+	vs[pivot], vs[right] = vs[right], vs[pivot]
+	store := left
+	for i := left; i < right; i++ {
+		if o(vs[i], pv) < 0 {
+			vs[store], vs[i] = vs[i], vs[store]
+			store++
+		}
+	}
+	vs[right], vs[store] = vs[store], vs[right]
+	return store
+}
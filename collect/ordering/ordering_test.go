@@ -0,0 +1,174 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ordering_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/abc-inc/goava/collect/compchain"
+	"github.com/abc-inc/goava/collect/ordering"
+	. "github.com/stretchr/testify/require"
+)
+
+var byLen = ordering.OnResultOf(func(s string) int { return len(s) }, ordering.Natural[int]())
+
+func TestNatural(t *testing.T) {
+	o := ordering.Natural[int]()
+	Equal(t, -1, o(1, 2))
+	Equal(t, 0, o.Compare(2, 2))
+	Equal(t, 1, o(3, 2))
+	Equal(t, 1, o.Reverse()(1, 2))
+}
+
+func TestFrom(t *testing.T) {
+	o := ordering.From(strings.Compare)
+	Equal(t, []string{"a", "b", "c"}, o.SortedCopy([]string{"c", "a", "b"}))
+}
+
+func TestOnResultOf(t *testing.T) {
+	Equal(t, []string{"a", "bb", "ccc"}, byLen.SortedCopy([]string{"ccc", "a", "bb"}))
+	Equal(t, []string{"ccc", "bb", "a"}, byLen.Reverse().SortedCopy([]string{"a", "ccc", "bb"}))
+}
+
+func TestCompound(t *testing.T) {
+	o := byLen.Compound(ordering.Natural[string]())
+	Equal(t, []string{"a", "b", "ab", "bb"}, o.SortedCopy([]string{"bb", "b", "ab", "a"}))
+
+	o = ordering.Compound[string]()
+	Equal(t, 0, o("a", "b"))
+}
+
+func TestLexicographical(t *testing.T) {
+	o := ordering.Lexicographical(ordering.Natural[int]())
+	vs := [][]int{{2}, {1, 2}, {1, 1}, {1}, {}}
+	Equal(t, [][]int{{}, {1}, {1, 1}, {1, 2}, {2}}, o.SortedCopy(vs))
+	True(t, o.IsStrictlyOrdered([][]int{nil, {1}, {1, 1}}))
+}
+
+func TestNilsFirst(t *testing.T) {
+	one, two := 1, 2
+	deref := ordering.OnResultOf(func(p *int) int { return *p }, ordering.Natural[int]())
+
+	vs := []*int{&two, nil, &one, nil}
+	Equal(t, []*int{nil, nil, &one, &two}, deref.NilsFirst().SortedCopy(vs))
+	Equal(t, []*int{&one, &two, nil, nil}, deref.NilsLast().SortedCopy(vs))
+	Equal(t, []*int{nil, nil, &two, &one}, deref.Reverse().NilsFirst().SortedCopy(vs))
+
+	errs := []error{strErr("b"), nil, strErr("a")}
+	o := ordering.OnResultOf(error.Error, ordering.Natural[string]()).NilsLast()
+	Equal(t, []error{strErr("a"), strErr("b"), nil}, o.SortedCopy(errs))
+
+	ss := [][]string{{"b"}, nil, {"a"}}
+	Equal(t, [][]string{nil, {"a"}, {"b"}},
+		ordering.Lexicographical(ordering.Natural[string]()).NilsFirst().SortedCopy(ss))
+}
+
+func TestMinMax(t *testing.T) {
+	o := byLen
+	Equal(t, "a", fst(o.Min("bb", "a", "c")))
+	Equal(t, "bb", fst(o.Max("a", "bb", "cc")))
+
+	_, err := o.Min()
+	EqualError(t, err, "values must not be empty")
+	_, err = o.Max()
+	EqualError(t, err, "values must not be empty")
+}
+
+func TestLeastOf(t *testing.T) {
+	o := ordering.Natural[int]()
+	vs := []int{5, 3, 9, 1, 7, 3}
+	Equal(t, []int{1, 3, 3}, fst(o.LeastOf(vs, 3)))
+	Equal(t, []int{9, 7}, fst(o.GreatestOf(vs, 2)))
+	Equal(t, []int{1, 3, 3, 5, 7, 9}, fst(o.LeastOf(vs, 10)))
+	Empty(t, fst(o.LeastOf(vs, 0)))
+	Equal(t, []int{5, 3, 9, 1, 7, 3}, vs)
+
+	_, err := o.LeastOf(vs, -1)
+	EqualError(t, err, "k cannot be negative but was: -1")
+}
+
+func TestLeastOf_random(t *testing.T) {
+	o := ordering.Natural[int]()
+	r := rand.New(rand.NewSource(42))
+	for n := 0; n < 100; n++ {
+		vs := r.Perm(n)
+		for i := range vs {
+			vs[i] %= 10
+		}
+		sorted := append([]int(nil), vs...)
+		sort.Ints(sorted)
+
+		k := r.Intn(n + 1)
+		Equal(t, sorted[:k], fst(o.LeastOf(vs, k)))
+	}
+}
+
+func TestIsOrdered(t *testing.T) {
+	o := ordering.Natural[int]()
+	True(t, o.IsOrdered(nil))
+	True(t, o.IsOrdered([]int{1, 1, 2}))
+	False(t, o.IsOrdered([]int{2, 1}))
+	True(t, o.IsStrictlyOrdered([]int{1, 2, 3}))
+	False(t, o.IsStrictlyOrdered([]int{1, 1, 2}))
+}
+
+func TestSortedCopy_stable(t *testing.T) {
+	Equal(t, []string{"b", "a", "dd", "cc"}, byLen.SortedCopy([]string{"dd", "b", "cc", "a"}))
+}
+
+func TestBinarySearch(t *testing.T) {
+	o := ordering.Natural[int]()
+	sorted := []int{1, 3, 5}
+
+	i, found := o.BinarySearch(sorted, 3)
+	Equal(t, 1, i)
+	True(t, found)
+
+	i, found = o.BinarySearch(sorted, 4)
+	Equal(t, 2, i)
+	False(t, found)
+
+	i, found = o.BinarySearch(nil, 4)
+	Equal(t, 0, i)
+	False(t, found)
+}
+
+func TestUntyped(t *testing.T) {
+	Equal(t, 1, compchain.Start().
+		CompareFunc("a", "b", byLen.Untyped()).
+		CompareFunc("b", "a", ordering.Natural[string]().Untyped()).
+		Result())
+
+	ci := func(l, r interface{}) int {
+		return strings.Compare(strings.ToLower(l.(string)), strings.ToLower(r.(string)))
+	}
+	Equal(t, []string{"a", "B", "c"}, ordering.FromUntyped[string](ci).SortedCopy([]string{"c", "B", "a"}))
+}
+
+type strErr string
+
+func (e strErr) Error() string {
+	return string(e)
+}
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+// BoundType indicates whether an endpoint of some range is contained in the range itself ("closed") or not ("open").
+//
+// If a range is unbounded on a side, it is neither open nor closed on that side; the bound simply does not exist.
+type BoundType int
+
+const (
+	// OpenBound indicates that the endpoint value is not considered part of the set ("exclusive").
+	OpenBound BoundType = iota
+	// ClosedBound indicates that the endpoint value is considered part of the set ("inclusive").
+	ClosedBound
+)
+
+// String returns a string representation of this BoundType.
+func (b BoundType) String() string {
+	if b == ClosedBound {
+		return "Closed"
+	}
+	return "Open"
+}
@@ -0,0 +1,272 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+import (
+	"cmp"
+	"math"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/collect/domain"
+)
+
+// ContiguousSet is an immutable sorted set of contiguous values in a given discrete domain, e.g., all int values
+// from 1 to 100.
+//
+// The values are not materialized: the size is computed by means of the domain's Distance function, and iteration
+// lazily advances from one value to the next.
+// Unbounded ranges are bounded by the minimum or maximum value of the domain, respectively.
+//
+// ContiguousSet instances are comparable, i.e., two sets are equal if they contain the same values.
+type ContiguousSet[C cmp.Ordered] struct {
+	first  C
+	last   C
+	empty  bool
+	domain domain.BoundedDiscreteDomain[C]
+}
+
+// NewContiguousSet returns a ContiguousSet containing the same values in the given domain contained by the range.
+func NewContiguousSet[C cmp.Ordered](r Range[C], d domain.BoundedDiscreteDomain[C]) ContiguousSet[C] {
+	r = r.Canonical(d)
+	if r.IsEmpty() {
+		return ContiguousSet[C]{empty: true, domain: d}
+	}
+
+	// a canonical range is closed below and either open above or unbounded
+	last := d.MaxValue()
+	if r.HasUpperBound() {
+		var err error
+		if last, err = d.Previous(r.upper.endpoint); err != nil {
+			return ContiguousSet[C]{empty: true, domain: d}
+		}
+	}
+	return ContiguousSet[C]{first: r.lower.endpoint, last: last, domain: d}
+}
+
+// CreateContiguousSet returns a ContiguousSet containing all values in the given domain from lower to upper, where
+// each endpoint may be either inclusive (closed) or exclusive (open).
+//
+// An error is returned if lower is greater than upper, or if both are equal and at least one endpoint is open.
+func CreateContiguousSet[C cmp.Ordered](lower C, lowerType BoundType, upper C, upperType BoundType,
+	d domain.BoundedDiscreteDomain[C]) (ContiguousSet[C], error) {
+
+	r, err := Of(lower, lowerType, upper, upperType)
+	if err != nil {
+		return ContiguousSet[C]{}, err
+	}
+	return NewContiguousSet(r, d), nil
+}
+
+// ClosedContiguousSet returns a ContiguousSet containing all values from lower to upper, both inclusive, in the given
+// domain.
+//
+// If lower is greater than upper, the returned set is empty.
+func ClosedContiguousSet[C cmp.Ordered](lower, upper C, d domain.BoundedDiscreteDomain[C]) ContiguousSet[C] {
+	if lower > upper {
+		return ContiguousSet[C]{empty: true, domain: d}
+	}
+	return ContiguousSet[C]{first: lower, last: upper, domain: d}
+}
+
+// Size returns the number of values in this set.
+//
+// If the set contains more than math.MaxInt values, math.MaxInt is returned.
+func (s ContiguousSet[C]) Size() int {
+	if s.empty {
+		return 0
+	}
+	dist, err := s.domain.Distance(s.first, s.last)
+	if err != nil || dist >= math.MaxInt {
+		return math.MaxInt
+	}
+	return int(dist) + 1
+}
+
+// IsEmpty returns true if this set contains no values.
+func (s ContiguousSet[C]) IsEmpty() bool {
+	return s.empty
+}
+
+// Contains returns true if this set contains the given value.
+func (s ContiguousSet[C]) Contains(v C) bool {
+	return !s.empty && s.first <= v && v <= s.last
+}
+
+// ContainsAll returns true if this set contains all the given values.
+func (s ContiguousSet[C]) ContainsAll(vs ...C) bool {
+	for _, v := range vs {
+		if !s.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// First returns the least value in this set, or an error if this set is empty.
+func (s ContiguousSet[C]) First() (C, error) {
+	if err := precond.CheckStatef(!s.empty, "empty set has no first element"); err != nil {
+		return s.first, err
+	}
+	return s.first, nil
+}
+
+// Last returns the greatest value in this set, or an error if this set is empty.
+func (s ContiguousSet[C]) Last() (C, error) {
+	if err := precond.CheckStatef(!s.empty, "empty set has no last element"); err != nil {
+		return s.last, err
+	}
+	return s.last, nil
+}
+
+// Range returns a range, closed on both ends, whose endpoints are the minimum and maximum values contained in this
+// set, or an error if this set is empty.
+func (s ContiguousSet[C]) Range() (Range[C], error) {
+	if err := precond.CheckStatef(!s.empty, "empty set has no range"); err != nil {
+		return Range[C]{}, err
+	}
+	return Range[C]{cut[C]{belowValue, s.first}, cut[C]{aboveValue, s.last}}, nil
+}
+
+// HeadSet returns the subset of values less than (or equal to, if inclusive) toElement.
+func (s ContiguousSet[C]) HeadSet(toElement C, inclusive bool) ContiguousSet[C] {
+	if inclusive {
+		return s.intersect(AtMost(toElement))
+	}
+	return s.intersect(LessThan(toElement))
+}
+
+// TailSet returns the subset of values greater than (or equal to, if inclusive) fromElement.
+func (s ContiguousSet[C]) TailSet(fromElement C, inclusive bool) ContiguousSet[C] {
+	if inclusive {
+		return s.intersect(AtLeast(fromElement))
+	}
+	return s.intersect(GreaterThan(fromElement))
+}
+
+// SubSet returns the subset of values ranging from fromElement to toElement.
+//
+// An error is returned if fromElement is greater than toElement.
+func (s ContiguousSet[C]) SubSet(fromElement C, fromInclusive bool, toElement C, toInclusive bool) (
+	ContiguousSet[C], error) {
+
+	if err := precond.CheckArgumentf(fromElement <= toElement,
+		"fromElement (%v) must not be greater than toElement (%v)", fromElement, toElement); err != nil {
+		return ContiguousSet[C]{}, err
+	}
+	if fromElement == toElement && !(fromInclusive && toInclusive) {
+		return ContiguousSet[C]{empty: true, domain: s.domain}, nil
+	}
+
+	lowerType, upperType := OpenBound, OpenBound
+	if fromInclusive {
+		lowerType = ClosedBound
+	}
+	if toInclusive {
+		upperType = ClosedBound
+	}
+	r, err := Of(fromElement, lowerType, toElement, upperType)
+	if err != nil {
+		return ContiguousSet[C]{}, err
+	}
+	return s.intersect(r), nil
+}
+
+// Intersection returns the set of values that are contained in both this and the other ContiguousSet.
+//
+// This set and other must have equal domains.
+func (s ContiguousSet[C]) Intersection(other ContiguousSet[C]) ContiguousSet[C] {
+	if other.empty {
+		return other
+	}
+	r, _ := other.Range()
+	return s.intersect(r)
+}
+
+// Iterator returns an iterator over the values in this set in ascending order.
+func (s ContiguousSet[C]) Iterator() *ContiguousSetIterator[C] {
+	return &ContiguousSetIterator[C]{s, s.first, s.empty}
+}
+
+// ForEach calls f for each value in this set in ascending order, until f returns false.
+func (s ContiguousSet[C]) ForEach(f func(v C) bool) {
+	it := s.Iterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		if !f(v) {
+			return
+		}
+	}
+}
+
+// ToSlice returns a slice containing all values in this set in ascending order.
+//
+// Note that this materializes all values, which might exceed the available memory for large sets.
+func (s ContiguousSet[C]) ToSlice() []C {
+	vs := make([]C, 0, s.Size())
+	s.ForEach(func(v C) bool {
+		vs = append(vs, v)
+		return true
+	})
+	return vs
+}
+
+// String returns a string representation of this set, such as "[1..5]", or "[]" if this set is empty.
+func (s ContiguousSet[C]) String() string {
+	if s.empty {
+		return "[]"
+	}
+	r, _ := s.Range()
+	return r.String()
+}
+
+// intersect returns the subset of values, which are also contained in r.
+func (s ContiguousSet[C]) intersect(r Range[C]) ContiguousSet[C] {
+	own, err := s.Range()
+	if err != nil || !own.IsConnected(r) {
+		return ContiguousSet[C]{empty: true, domain: s.domain}
+	}
+	r, _ = own.Intersection(r)
+	return NewContiguousSet(r, s.domain)
+}
+
+// ContiguousSetIterator is an iterator over the values of a ContiguousSet.
+type ContiguousSetIterator[C cmp.Ordered] struct {
+	set  ContiguousSet[C]
+	next C
+	done bool
+}
+
+// HasNext returns true if the iteration has more values.
+func (it *ContiguousSetIterator[C]) HasNext() bool {
+	return !it.done
+}
+
+// Next returns the next value and true, or the zero value and false if the iteration has no more values.
+func (it *ContiguousSetIterator[C]) Next() (C, bool) {
+	if it.done {
+		var zero C
+		return zero, false
+	}
+
+	v := it.next
+	if v == it.set.last {
+		// do not advance beyond the last value to avoid overflows at the domain's MaxValue
+		it.done = true
+	} else if next, err := it.set.domain.Next(v); err != nil {
+		it.done = true
+	} else {
+		it.next = next
+	}
+	return v, true
+}
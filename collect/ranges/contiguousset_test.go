@@ -0,0 +1,154 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestNewContiguousSet(t *testing.T) {
+	d := domain.Int{}
+	tests := []struct {
+		r    ranges.Range[int]
+		str  string
+		size int
+	}{
+		{fst(ranges.Closed(1, 3)), "[1..3]", 3},
+		{fst(ranges.Open(1, 3)), "[2..2]", 1},
+		{fst(ranges.OpenClosed(1, 3)), "[2..3]", 2},
+		{fst(ranges.Open(1, 2)), "[]", 0},
+		{fst(ranges.ClosedOpen(1, 1)), "[]", 0},
+		{ranges.AtLeast(math.MaxInt - 1), "[9223372036854775806..9223372036854775807]", 2},
+		{ranges.LessThan(math.MinInt + 1), "[-9223372036854775808..-9223372036854775808]", 1},
+		{ranges.GreaterThan(math.MaxInt), "[]", 0},
+		{ranges.All[int](), "[-9223372036854775808..9223372036854775807]", math.MaxInt},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.r.String(), func(t *testing.T) {
+			s := ranges.NewContiguousSet(tc.r, d)
+			Equal(t, tc.str, s.String())
+			Equal(t, tc.size, s.Size())
+			Equal(t, tc.size == 0, s.IsEmpty())
+		})
+	}
+}
+
+func TestCreateContiguousSet(t *testing.T) {
+	d := domain.Int32{}
+	s := fst(ranges.CreateContiguousSet[int32](1, ranges.OpenBound, 5, ranges.ClosedBound, d))
+	Equal(t, []int32{2, 3, 4, 5}, s.ToSlice())
+	Equal(t, int32(2), fst(s.First()))
+	Equal(t, int32(5), fst(s.Last()))
+	Equal(t, "[2..5]", fst(s.Range()).String())
+
+	_, err := ranges.CreateContiguousSet[int32](5, ranges.OpenBound, 1, ranges.ClosedBound, d)
+	EqualError(t, err, "invalid range: (5..1]")
+
+	s = ranges.ClosedContiguousSet[int32](math.MaxInt32, math.MinInt32, d)
+	True(t, s.IsEmpty())
+	_, err = s.First()
+	EqualError(t, err, "empty set has no first element")
+	_, err = s.Last()
+	EqualError(t, err, "empty set has no last element")
+	_, err = s.Range()
+	EqualError(t, err, "empty set has no range")
+	Empty(t, s.ToSlice())
+}
+
+func TestContiguousSet_Size(t *testing.T) {
+	Equal(t, math.MaxInt32-math.MinInt32+1,
+		ranges.ClosedContiguousSet[int32](math.MinInt32, math.MaxInt32, domain.Int32{}).Size())
+	Equal(t, math.MaxInt, ranges.NewContiguousSet(ranges.All[int64](), domain.Int64{}).Size())
+	Equal(t, math.MaxInt, ranges.NewContiguousSet(ranges.AtLeast[int64](-1), domain.Int64{}).Size())
+	Equal(t, math.MaxInt, ranges.NewContiguousSet(ranges.AtLeast[int64](0), domain.Int64{}).Size())
+	Equal(t, math.MaxInt, ranges.NewContiguousSet(ranges.AtLeast[int64](1), domain.Int64{}).Size())
+	Equal(t, math.MaxInt-1, ranges.NewContiguousSet(ranges.AtLeast[int64](2), domain.Int64{}).Size())
+}
+
+func TestContiguousSet_Contains(t *testing.T) {
+	s := ranges.ClosedContiguousSet(1, 3, domain.Int{})
+	True(t, s.ContainsAll(1, 2, 3))
+	False(t, s.Contains(0))
+	False(t, s.Contains(4))
+	False(t, ranges.ClosedContiguousSet(1, 0, domain.Int{}).Contains(0))
+}
+
+func TestContiguousSet_Iterator(t *testing.T) {
+	s := ranges.NewContiguousSet(ranges.AtLeast(math.MaxInt-2), domain.Int{})
+	it := s.Iterator()
+	var vs []int
+	for it.HasNext() {
+		v, ok := it.Next()
+		True(t, ok)
+		vs = append(vs, v)
+	}
+	Equal(t, []int{math.MaxInt - 2, math.MaxInt - 1, math.MaxInt}, vs)
+	_, ok := it.Next()
+	False(t, ok)
+
+	vs = nil
+	ranges.NewContiguousSet(ranges.All[int](), domain.Int{}).ForEach(func(v int) bool {
+		vs = append(vs, v)
+		return len(vs) < 3
+	})
+	Equal(t, []int{math.MinInt, math.MinInt + 1, math.MinInt + 2}, vs)
+}
+
+func TestContiguousSet_HeadSet_TailSet(t *testing.T) {
+	s := ranges.ClosedContiguousSet(1, 5, domain.Int{})
+	Equal(t, []int{1, 2, 3}, s.HeadSet(3, true).ToSlice())
+	Equal(t, []int{1, 2}, s.HeadSet(3, false).ToSlice())
+	True(t, s.HeadSet(1, false).IsEmpty())
+	Equal(t, s, s.HeadSet(10, false))
+
+	Equal(t, []int{3, 4, 5}, s.TailSet(3, true).ToSlice())
+	Equal(t, []int{4, 5}, s.TailSet(3, false).ToSlice())
+	True(t, s.TailSet(5, false).IsEmpty())
+	Equal(t, s, s.TailSet(-10, false))
+}
+
+func TestContiguousSet_SubSet(t *testing.T) {
+	s := ranges.ClosedContiguousSet(1, 5, domain.Int{})
+	Equal(t, []int{2, 3, 4}, fst(s.SubSet(2, true, 4, true)).ToSlice())
+	Equal(t, []int{3}, fst(s.SubSet(2, false, 4, false)).ToSlice())
+	Equal(t, []int{3}, fst(s.SubSet(3, true, 3, true)).ToSlice())
+	True(t, fst(s.SubSet(3, true, 3, false)).IsEmpty())
+	True(t, fst(s.SubSet(7, true, 9, false)).IsEmpty())
+
+	_, err := s.SubSet(3, true, 2, true)
+	EqualError(t, err, "fromElement (3) must not be greater than toElement (2)")
+}
+
+func TestContiguousSet_Intersection(t *testing.T) {
+	d := domain.Int{}
+	s := ranges.ClosedContiguousSet(1, 5, d)
+	Equal(t, "[3..5]", s.Intersection(ranges.ClosedContiguousSet(3, 7, d)).String())
+	Equal(t, "[]", s.Intersection(ranges.ClosedContiguousSet(6, 7, d)).String())
+	Equal(t, "[]", s.Intersection(ranges.ClosedContiguousSet(1, 0, d)).String())
+	Equal(t, ranges.ClosedContiguousSet(3, 4, d), s.Intersection(ranges.NewContiguousSet(fst(ranges.Open(2, 5)), d)))
+}
+
+func TestContiguousSet_Domains(t *testing.T) {
+	Equal(t, []uint8{253, 254, 255}, ranges.NewContiguousSet(ranges.GreaterThan[uint8](252), domain.Uint8{}).ToSlice())
+	Equal(t, []rune("abc"), ranges.ClosedContiguousSet('a', 'c', domain.Rune{}).ToSlice())
+	Equal(t, 0x110000, ranges.NewContiguousSet(ranges.All[rune](), domain.Rune{}).Size())
+	Equal(t, math.MaxInt, ranges.NewContiguousSet(ranges.All[uint64](), domain.Uint64{}).Size())
+}
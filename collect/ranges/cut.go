@@ -0,0 +1,103 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+import (
+	"cmp"
+
+	"github.com/abc-inc/goava/collect/domain"
+)
+
+// cutKind determines the position of a cut relative to its endpoint.
+type cutKind uint8
+
+const (
+	belowAll cutKind = iota
+	belowValue
+	aboveValue
+	aboveAll
+)
+
+// cut is the boundary of a range: it is either below all values, above all values, or just below or just above a
+// particular value.
+type cut[C cmp.Ordered] struct {
+	kind     cutKind
+	endpoint C
+}
+
+// compareCuts compares two cuts.
+//
+// A cut below a value is less than the cut above the same value.
+func compareCuts[C cmp.Ordered](a, b cut[C]) int {
+	if a.kind == b.kind && (a.kind == belowAll || a.kind == aboveAll) {
+		return 0
+	}
+	switch {
+	case a.kind == belowAll || b.kind == aboveAll:
+		return -1
+	case a.kind == aboveAll || b.kind == belowAll:
+		return 1
+	}
+	if c := cmp.Compare(a.endpoint, b.endpoint); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.kind, b.kind)
+}
+
+// isLessThan returns whether the cut is located below the given value.
+func (c cut[C]) isLessThan(v C) bool {
+	switch c.kind {
+	case belowAll:
+		return true
+	case belowValue:
+		return c.endpoint <= v
+	case aboveValue:
+		return c.endpoint < v
+	default:
+		return false
+	}
+}
+
+// canonical returns the canonical representation of the cut in the given discrete domain.
+//
+// Canonical cuts are either located below a value, or above all values.
+func (c cut[C]) canonical(d domain.BoundedDiscreteDomain[C]) cut[C] {
+	switch c.kind {
+	case belowAll:
+		return cut[C]{belowValue, d.MinValue()}
+	case aboveValue:
+		next, err := d.Next(c.endpoint)
+		if err != nil {
+			return cut[C]{kind: aboveAll}
+		}
+		return cut[C]{belowValue, next}
+	default:
+		return c
+	}
+}
+
+func minCut[C cmp.Ordered](a, b cut[C]) cut[C] {
+	if compareCuts(a, b) <= 0 {
+		return a
+	}
+	return b
+}
+
+func maxCut[C cmp.Ordered](a, b cut[C]) cut[C] {
+	if compareCuts(a, b) >= 0 {
+		return a
+	}
+	return b
+}
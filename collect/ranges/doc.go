@@ -0,0 +1,39 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ranges provides ranges of comparable values and collections thereof.
+//
+// A Range defines the boundaries around a contiguous span of values of some ordered type, for example
+// "integers from 1 to 100 inclusive". Note that it is not possible to iterate over these contained values.
+//
+// A TreeRangeSet is a set of disconnected, nonempty ranges, where connected ranges are coalesced.
+// A TreeRangeMap maps disjoint, nonempty ranges to values.
+// A ContiguousSet is a sorted set of all values in a range of a discrete domain, which are iterated lazily.
+//
+// Ranges of discrete values (such as int) can be canonicalized with a discrete domain from package domain, e.g.,
+// domain.Int. In that case, [1..3] and [4..6] are recognized as being connected, because there are no values in
+// between.
+//
+// All types are constrained to cmp.Ordered, i.e., endpoints must be integers, floats or strings. Types with a Compare or
+// Cmp method, such as time.Time and *big.Int, cannot be used as endpoints directly. Instead, ranges of points in time,
+// e.g., for scheduling time windows, can be expressed in Unix time:
+//
+//	window, _ := ranges.ClosedOpen(start.UnixNano(), end.UnixNano())
+//	if window.Contains(t.UnixNano()) {
+//		...
+//	}
+//
+// Likewise, ranges of calendar days can be expressed as days since the Unix epoch (see domain.Days.Distance) and
+// canonicalized with domain.Int64.
+package ranges
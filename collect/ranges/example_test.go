@@ -0,0 +1,47 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges_test
+
+import (
+	"fmt"
+
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+)
+
+func ExampleTreeRangeSet() {
+	r1, _ := ranges.Closed(1, 10)
+	r2, _ := ranges.ClosedOpen(11, 15)
+	r3, _ := ranges.ClosedOpen(15, 20)
+	r4, _ := ranges.Open(5, 10)
+
+	s := ranges.NewTreeRangeSet(r1, r2, r3)
+	fmt.Println(s)
+	s.Remove(r4)
+	fmt.Println(s)
+	fmt.Println(s.Complement())
+	// Output:
+	// [[1..10], [11..20)]
+	// [[1..5], [10..10], [11..20)]
+	// [(-∞..1), (5..10), (10..11), [20..+∞)]
+}
+
+func ExampleContiguousSet() {
+	r, _ := ranges.OpenClosed(1, 5)
+	s := ranges.NewContiguousSet(r, domain.Int{})
+	fmt.Println(s, s.Size(), s.ToSlice())
+	// Output:
+	// [2..5] 4 [2 3 4 5]
+}
@@ -0,0 +1,302 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/collect/domain"
+)
+
+// Range is an immutable range (or "interval") boundary around a contiguous span of values of some ordered type,
+// for example, "integers from 1 to 100 inclusive".
+//
+// Each end of the range may be bounded or unbounded. If bounded, there is an associated endpoint value, and the range
+// is considered to be either open (does not include the endpoint) or closed (includes the endpoint) on that side.
+// With three possibilities on each side, this yields nine basic types of ranges:
+//
+//	(a..b)  {x | a < x < b}   Open(a, b)
+//	[a..b]  {x | a <= x <= b} Closed(a, b)
+//	(a..b]  {x | a < x <= b}  OpenClosed(a, b)
+//	[a..b)  {x | a <= x < b}  ClosedOpen(a, b)
+//	(a..+∞) {x | x > a}       GreaterThan(a)
+//	[a..+∞) {x | x >= a}      AtLeast(a)
+//	(-∞..b) {x | x < b}       LessThan(b)
+//	(-∞..b] {x | x <= b}      AtMost(b)
+//	(-∞..+∞) {x}              All()
+//
+// A range is empty if it contains no values, e.g., [a..a) or (a..a].
+// Ranges are comparable, i.e., two ranges are equal if they have the same endpoints and bound types.
+type Range[C cmp.Ordered] struct {
+	lower cut[C]
+	upper cut[C]
+}
+
+// Open returns a range that contains all values strictly greater than lower and strictly less than upper.
+func Open[C cmp.Ordered](lower, upper C) (Range[C], error) {
+	return newRange(cut[C]{aboveValue, lower}, cut[C]{belowValue, upper})
+}
+
+// Closed returns a range that contains all values greater than or equal to lower and less than or equal to upper.
+func Closed[C cmp.Ordered](lower, upper C) (Range[C], error) {
+	return newRange(cut[C]{belowValue, lower}, cut[C]{aboveValue, upper})
+}
+
+// ClosedOpen returns a range that contains all values greater than or equal to lower and strictly less than upper.
+func ClosedOpen[C cmp.Ordered](lower, upper C) (Range[C], error) {
+	return newRange(cut[C]{belowValue, lower}, cut[C]{belowValue, upper})
+}
+
+// OpenClosed returns a range that contains all values strictly greater than lower and less than or equal to upper.
+func OpenClosed[C cmp.Ordered](lower, upper C) (Range[C], error) {
+	return newRange(cut[C]{aboveValue, lower}, cut[C]{aboveValue, upper})
+}
+
+// Of returns a range that contains any value from lower to upper, where each endpoint may be either inclusive
+// (closed) or exclusive (open).
+func Of[C cmp.Ordered](lower C, lowerType BoundType, upper C, upperType BoundType) (Range[C], error) {
+	l := cut[C]{aboveValue, lower}
+	if lowerType == ClosedBound {
+		l.kind = belowValue
+	}
+	u := cut[C]{belowValue, upper}
+	if upperType == ClosedBound {
+		u.kind = aboveValue
+	}
+	return newRange(l, u)
+}
+
+// LessThan returns a range that contains all values strictly less than endpoint.
+func LessThan[C cmp.Ordered](endpoint C) Range[C] {
+	return Range[C]{cut[C]{kind: belowAll}, cut[C]{belowValue, endpoint}}
+}
+
+// AtMost returns a range that contains all values less than or equal to endpoint.
+func AtMost[C cmp.Ordered](endpoint C) Range[C] {
+	return Range[C]{cut[C]{kind: belowAll}, cut[C]{aboveValue, endpoint}}
+}
+
+// GreaterThan returns a range that contains all values strictly greater than endpoint.
+func GreaterThan[C cmp.Ordered](endpoint C) Range[C] {
+	return Range[C]{cut[C]{aboveValue, endpoint}, cut[C]{kind: aboveAll}}
+}
+
+// AtLeast returns a range that contains all values greater than or equal to endpoint.
+func AtLeast[C cmp.Ordered](endpoint C) Range[C] {
+	return Range[C]{cut[C]{belowValue, endpoint}, cut[C]{kind: aboveAll}}
+}
+
+// All returns a range that contains every value of type C.
+func All[C cmp.Ordered]() Range[C] {
+	return Range[C]{cut[C]{kind: belowAll}, cut[C]{kind: aboveAll}}
+}
+
+// Singleton returns a range that contains only the given value.
+// The returned range is closed on both ends.
+func Singleton[C cmp.Ordered](v C) Range[C] {
+	return Range[C]{cut[C]{belowValue, v}, cut[C]{aboveValue, v}}
+}
+
+// Encloses returns the minimal range that contains all the given values.
+// The returned range is closed on both ends.
+func Encloses[C cmp.Ordered](vs ...C) (Range[C], error) {
+	if err := precond.CheckArgumentf(len(vs) > 0, "values must not be empty"); err != nil {
+		return Range[C]{}, err
+	}
+	lo, hi := vs[0], vs[0]
+	for _, v := range vs[1:] {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	return Range[C]{cut[C]{belowValue, lo}, cut[C]{aboveValue, hi}}, nil
+}
+
+func newRange[C cmp.Ordered](lower, upper cut[C]) (Range[C], error) {
+	r := Range[C]{lower, upper}
+	if err := precond.CheckArgumentf(compareCuts(lower, upper) <= 0 && lower.kind != aboveAll && upper.kind != belowAll,
+		"invalid range: %s", r); err != nil {
+		return Range[C]{}, err
+	}
+	return r, nil
+}
+
+// HasLowerBound returns true if this range has a lower endpoint.
+func (r Range[C]) HasLowerBound() bool {
+	return r.lower.kind != belowAll
+}
+
+// LowerEndpoint returns the lower endpoint of this range, or an error if the range is unbounded below.
+func (r Range[C]) LowerEndpoint() (C, error) {
+	if err := precond.CheckStatef(r.HasLowerBound(), "range unbounded on this side"); err != nil {
+		return r.lower.endpoint, err
+	}
+	return r.lower.endpoint, nil
+}
+
+// LowerBoundType returns the type of this range's lower bound, or an error if the range is unbounded below.
+func (r Range[C]) LowerBoundType() (BoundType, error) {
+	if err := precond.CheckStatef(r.HasLowerBound(), "range unbounded on this side"); err != nil {
+		return OpenBound, err
+	}
+	if r.lower.kind == belowValue {
+		return ClosedBound, nil
+	}
+	return OpenBound, nil
+}
+
+// HasUpperBound returns true if this range has an upper endpoint.
+func (r Range[C]) HasUpperBound() bool {
+	return r.upper.kind != aboveAll
+}
+
+// UpperEndpoint returns the upper endpoint of this range, or an error if the range is unbounded above.
+func (r Range[C]) UpperEndpoint() (C, error) {
+	if err := precond.CheckStatef(r.HasUpperBound(), "range unbounded on this side"); err != nil {
+		return r.upper.endpoint, err
+	}
+	return r.upper.endpoint, nil
+}
+
+// UpperBoundType returns the type of this range's upper bound, or an error if the range is unbounded above.
+func (r Range[C]) UpperBoundType() (BoundType, error) {
+	if err := precond.CheckStatef(r.HasUpperBound(), "range unbounded on this side"); err != nil {
+		return OpenBound, err
+	}
+	if r.upper.kind == aboveValue {
+		return ClosedBound, nil
+	}
+	return OpenBound, nil
+}
+
+// IsEmpty returns true if this range is of the form [v..v) or (v..v].
+//
+// Note that this is not the same as "does not contain any values" for discrete types: e.g., (3..4) over int does not
+// contain any values, but is not considered empty unless canonicalized.
+func (r Range[C]) IsEmpty() bool {
+	return compareCuts(r.lower, r.upper) == 0
+}
+
+// Contains returns true if value is within the bounds of this range.
+func (r Range[C]) Contains(v C) bool {
+	return r.lower.isLessThan(v) && !r.upper.isLessThan(v)
+}
+
+// ContainsAll returns true if every element in values is contained in this range.
+func (r Range[C]) ContainsAll(vs ...C) bool {
+	for _, v := range vs {
+		if !r.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Encloses returns true if the bounds of other do not extend outside the bounds of this range.
+//
+// Note that every range encloses itself, and that encloses is a partial order.
+func (r Range[C]) Encloses(other Range[C]) bool {
+	return compareCuts(r.lower, other.lower) <= 0 && compareCuts(r.upper, other.upper) >= 0
+}
+
+// IsConnected returns true if there exists a (possibly empty) range which is enclosed by both this range and other.
+//
+// For example, [2..4) and [5..7) are not connected, whereas [2..4) and [3..5) as well as [2..4) and [4..6) are
+// connected.
+func (r Range[C]) IsConnected(other Range[C]) bool {
+	return compareCuts(r.lower, other.upper) <= 0 && compareCuts(other.lower, r.upper) <= 0
+}
+
+// Intersection returns the maximal range enclosed by both this range and connectedRange, if such a range exists.
+//
+// For example, the intersection of [1..5] and (3..7) is (3..5]. The resulting range may be empty; for example,
+// [1..5) intersected with [5..7) yields the empty range [5..5).
+// An error is returned if the ranges are not connected.
+func (r Range[C]) Intersection(connectedRange Range[C]) (Range[C], error) {
+	if err := precond.CheckArgumentf(r.IsConnected(connectedRange),
+		"intersection is undefined for disconnected ranges %s and %s", r, connectedRange); err != nil {
+		return Range[C]{}, err
+	}
+	return Range[C]{maxCut(r.lower, connectedRange.lower), minCut(r.upper, connectedRange.upper)}, nil
+}
+
+// Gap returns the maximal range lying between this range and otherRange, if such a range exists.
+//
+// For example, the gap between [1..5] and (7..10) is (5..7]. The resulting range may be empty; for example, the gap
+// between [1..5) and [5..7) yields the empty range [5..5).
+// An error is returned if the ranges have a nonempty intersection.
+func (r Range[C]) Gap(otherRange Range[C]) (Range[C], error) {
+	first, second := r, otherRange
+	if compareCuts(r.lower, otherRange.lower) > 0 {
+		first, second = otherRange, r
+	}
+	if err := precond.CheckArgumentf(compareCuts(first.upper, second.lower) <= 0,
+		"ranges have a nonempty intersection: %s, %s", r, otherRange); err != nil {
+		return Range[C]{}, err
+	}
+	return Range[C]{first.upper, second.lower}, nil
+}
+
+// Span returns the minimal range that encloses both this range and other.
+//
+// For example, the span of [1..3] and (5..7) is [1..7).
+func (r Range[C]) Span(other Range[C]) Range[C] {
+	return Range[C]{minCut(r.lower, other.lower), maxCut(r.upper, other.upper)}
+}
+
+// Canonical returns the canonical form of this range in the given discrete domain.
+//
+// The canonical form has the following properties:
+//
+// • equivalence: Canonical(d).Contains(v) == Contains(v) for all v
+//
+// • uniqueness: unless IsEmpty(), a.Canonical(d) == b.Canonical(d) implies a == b
+//
+// • idempotence: Canonical(d).Canonical(d) == Canonical(d)
+//
+// The canonical form of a range is closed below and open above, e.g., (1..5] over domain.Int yields [2..6), unless it
+// is unbounded above. As a consequence, ranges like (3..4) are converted to empty ranges.
+func (r Range[C]) Canonical(d domain.BoundedDiscreteDomain[C]) Range[C] {
+	return Range[C]{r.lower.canonical(d), r.upper.canonical(d)}
+}
+
+// String returns a string representation of this range, such as "[3..5)" (other examples are listed in the
+// documentation of Range).
+func (r Range[C]) String() string {
+	sb := strings.Builder{}
+	switch r.lower.kind {
+	case belowAll:
+		sb.WriteString("(-∞")
+	case belowValue:
+		sb.WriteString("[" + fmt.Sprint(r.lower.endpoint))
+	case aboveAll:
+		// only possible for empty ranges like the canonical form of (MaxValue..+∞)
+		sb.WriteString("(+∞")
+	default:
+		sb.WriteString("(" + fmt.Sprint(r.lower.endpoint))
+	}
+	sb.WriteString("..")
+	switch r.upper.kind {
+	case aboveAll:
+		sb.WriteString("+∞)")
+	case aboveValue:
+		sb.WriteString(fmt.Sprint(r.upper.endpoint) + "]")
+	default:
+		sb.WriteString(fmt.Sprint(r.upper.endpoint) + ")")
+	}
+	return sb.String()
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	r := fst(ranges.Open(4, 8))
+	False(t, r.Contains(4))
+	True(t, r.Contains(5))
+	True(t, r.Contains(7))
+	False(t, r.Contains(8))
+	True(t, r.HasLowerBound())
+	True(t, r.HasUpperBound())
+	Equal(t, 4, fst(r.LowerEndpoint()))
+	Equal(t, ranges.OpenBound, fst(r.LowerBoundType()))
+	Equal(t, 8, fst(r.UpperEndpoint()))
+	Equal(t, ranges.OpenBound, fst(r.UpperBoundType()))
+	False(t, r.IsEmpty())
+	Equal(t, "(4..8)", r.String())
+}
+
+func TestOpen_invalid(t *testing.T) {
+	_, err := ranges.Open(4, 3)
+	EqualError(t, err, "invalid range: (4..3)")
+	_, err = ranges.Open(3, 3)
+	EqualError(t, err, "invalid range: (3..3)")
+}
+
+func TestClosed(t *testing.T) {
+	r := fst(ranges.Closed(5, 7))
+	False(t, r.Contains(4))
+	True(t, r.Contains(5))
+	True(t, r.Contains(7))
+	False(t, r.Contains(8))
+	Equal(t, ranges.ClosedBound, fst(r.LowerBoundType()))
+	Equal(t, ranges.ClosedBound, fst(r.UpperBoundType()))
+	Equal(t, "[5..7]", r.String())
+
+	_, err := ranges.Closed(4, 3)
+	EqualError(t, err, "invalid range: [4..3]")
+}
+
+func TestClosedOpen_empty(t *testing.T) {
+	r := fst(ranges.ClosedOpen(4, 4))
+	False(t, r.Contains(4))
+	True(t, r.IsEmpty())
+	Equal(t, "[4..4)", r.String())
+
+	r = fst(ranges.OpenClosed(4, 4))
+	False(t, r.Contains(4))
+	True(t, r.IsEmpty())
+	Equal(t, "(4..4]", r.String())
+}
+
+func TestOf(t *testing.T) {
+	Equal(t, fst(ranges.ClosedOpen(1, 2)), fst(ranges.Of(1, ranges.ClosedBound, 2, ranges.OpenBound)))
+	Equal(t, fst(ranges.OpenClosed(1, 2)), fst(ranges.Of(1, ranges.OpenBound, 2, ranges.ClosedBound)))
+}
+
+func TestUnbounded(t *testing.T) {
+	tests := []struct {
+		r     ranges.Range[int]
+		str   string
+		in    []int
+		notIn []int
+	}{
+		{ranges.LessThan(5), "(-∞..5)", []int{math.MinInt, 4}, []int{5, 6}},
+		{ranges.AtMost(5), "(-∞..5]", []int{math.MinInt, 5}, []int{6}},
+		{ranges.GreaterThan(5), "(5..+∞)", []int{6, math.MaxInt}, []int{4, 5}},
+		{ranges.AtLeast(5), "[5..+∞)", []int{5, math.MaxInt}, []int{4}},
+		{ranges.All[int](), "(-∞..+∞)", []int{math.MinInt, 0, math.MaxInt}, nil},
+		{ranges.Singleton(5), "[5..5]", []int{5}, []int{4, 6}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			Equal(t, tc.str, tc.r.String())
+			True(t, tc.r.ContainsAll(tc.in...))
+			for _, v := range tc.notIn {
+				False(t, tc.r.Contains(v))
+			}
+		})
+	}
+
+	_, err := ranges.LessThan(5).LowerEndpoint()
+	EqualError(t, err, "range unbounded on this side")
+	_, err = ranges.AtLeast(5).UpperBoundType()
+	EqualError(t, err, "range unbounded on this side")
+}
+
+func TestEncloses(t *testing.T) {
+	Equal(t, fst(ranges.Closed(1, 9)), fst(ranges.Encloses(5, 1, 9, 3)))
+	_, err := ranges.Encloses[int]()
+	Error(t, err)
+
+	r := fst(ranges.Closed(2, 5))
+	True(t, r.Encloses(r))
+	True(t, r.Encloses(fst(ranges.Open(2, 5))))
+	True(t, r.Encloses(fst(ranges.ClosedOpen(2, 2))))
+	False(t, r.Encloses(fst(ranges.Closed(1, 5))))
+	False(t, fst(ranges.Open(2, 5)).Encloses(r))
+	True(t, ranges.All[int]().Encloses(r))
+}
+
+func TestIsConnected(t *testing.T) {
+	True(t, fst(ranges.Closed(3, 5)).IsConnected(fst(ranges.Open(5, 6))))
+	True(t, fst(ranges.Closed(3, 5)).IsConnected(fst(ranges.ClosedOpen(5, 5))))
+	True(t, fst(ranges.Open(3, 5)).IsConnected(fst(ranges.Closed(5, 6))))
+	False(t, fst(ranges.Open(3, 5)).IsConnected(fst(ranges.Open(5, 6))))
+	False(t, fst(ranges.Closed(1, 5)).IsConnected(fst(ranges.Closed(6, 10))))
+}
+
+func TestIntersection(t *testing.T) {
+	r := fst(ranges.Closed(1, 5))
+	Equal(t, "(3..5]", fst(r.Intersection(fst(ranges.Open(3, 7)))).String())
+	Equal(t, "[5..5)", fst(fst(ranges.ClosedOpen(1, 5)).Intersection(fst(ranges.ClosedOpen(5, 7)))).String())
+	Equal(t, r, fst(r.Intersection(ranges.All[int]())))
+
+	_, err := r.Intersection(fst(ranges.Closed(6, 7)))
+	EqualError(t, err, "intersection is undefined for disconnected ranges [1..5] and [6..7]")
+}
+
+func TestGap(t *testing.T) {
+	Equal(t, "(5..7]", fst(fst(ranges.Closed(1, 5)).Gap(fst(ranges.Open(7, 10)))).String())
+	Equal(t, "(5..7]", fst(fst(ranges.Open(7, 10)).Gap(fst(ranges.Closed(1, 5)))).String())
+	Equal(t, "[5..5)", fst(fst(ranges.ClosedOpen(1, 5)).Gap(fst(ranges.ClosedOpen(5, 7)))).String())
+
+	_, err := fst(ranges.Closed(1, 5)).Gap(fst(ranges.Closed(5, 7)))
+	EqualError(t, err, "ranges have a nonempty intersection: [1..5], [5..7]")
+}
+
+func TestSpan(t *testing.T) {
+	Equal(t, "[1..7)", fst(ranges.Closed(1, 3)).Span(fst(ranges.Open(5, 7))).String())
+	Equal(t, "(-∞..3]", fst(ranges.Closed(1, 3)).Span(ranges.LessThan(2)).String())
+}
+
+func TestCanonical(t *testing.T) {
+	d := domain.Int{}
+	Equal(t, "[2..6)", fst(ranges.OpenClosed(1, 5)).Canonical(d).String())
+	Equal(t, "[1..5)", fst(ranges.ClosedOpen(1, 5)).Canonical(d).String())
+	Equal(t, "[-9223372036854775808..6)", ranges.AtMost(5).Canonical(d).String())
+	Equal(t, "[6..+∞)", ranges.GreaterThan(5).Canonical(d).String())
+	True(t, fst(ranges.Open(3, 4)).Canonical(d).IsEmpty())
+	True(t, ranges.GreaterThan(math.MaxInt).Canonical(d).IsEmpty())
+
+	r := fst(ranges.Closed(1, 5)).Canonical(d)
+	Equal(t, r, r.Canonical(d))
+	Equal(t, r, fst(ranges.Open(0, 6)).Canonical(d))
+
+	Equal(t, "[3..+∞)", fst(ranges.Closed[int64](3, math.MaxInt64)).Canonical(domain.Int64{}).String())
+}
+
+func TestBoundType_String(t *testing.T) {
+	Equal(t, "Open", ranges.OpenBound.String())
+	Equal(t, "Closed", ranges.ClosedBound.String())
+}
+
+func fst[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,214 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/collect/domain"
+)
+
+// MapEntry is a mapping from a range to a value in a TreeRangeMap.
+type MapEntry[K cmp.Ordered, V comparable] struct {
+	Range Range[K]
+	Value V
+}
+
+// TreeRangeMap is a mapping from disjoint nonempty ranges to values.
+//
+// Queries look up the value associated with the range (if any) that contains a specified key.
+// In contrast to TreeRangeSet, no "coalescing" is done of connected ranges, even if they are mapped to the same value,
+// unless PutCoalescing is used. If the map was created with a discrete domain, ranges are canonicalized before they
+// are put.
+//
+// The entries are kept in a slice sorted by their lower bounds, which allows for logarithmic look-ups.
+// The zero value is an empty map of a continuous domain, ready to use.
+type TreeRangeMap[K cmp.Ordered, V comparable] struct {
+	entries []MapEntry[K, V]
+	domain  domain.BoundedDiscreteDomain[K]
+}
+
+// NewTreeRangeMap returns a new, empty map.
+func NewTreeRangeMap[K cmp.Ordered, V comparable]() *TreeRangeMap[K, V] {
+	return &TreeRangeMap[K, V]{}
+}
+
+// NewDiscreteTreeRangeMap returns a new, empty map, whose ranges are canonicalized in the given domain.
+func NewDiscreteTreeRangeMap[K cmp.Ordered, V comparable](d domain.BoundedDiscreteDomain[K]) *TreeRangeMap[K, V] {
+	return &TreeRangeMap[K, V]{domain: d}
+}
+
+// Get returns the value associated with the specified key, if any.
+func (m *TreeRangeMap[K, V]) Get(k K) (V, bool) {
+	e, ok := m.GetEntry(k)
+	return e.Value, ok
+}
+
+// GetEntry returns the range containing this key and its associated value, if such a range is present.
+func (m *TreeRangeMap[K, V]) GetEntry(k K) (MapEntry[K, V], bool) {
+	i := sort.Search(len(m.entries), func(i int) bool { return !m.entries[i].Range.upper.isLessThan(k) })
+	if i < len(m.entries) && m.entries[i].Range.Contains(k) {
+		return m.entries[i], true
+	}
+	return MapEntry[K, V]{}, false
+}
+
+// Put maps a range to a specified value.
+//
+// If the range is empty, then this is a no-op. Otherwise, any existing mappings of keys in the range are overwritten,
+// i.e., ranges overlapping with r are split or truncated as necessary.
+func (m *TreeRangeMap[K, V]) Put(r Range[K], v V) {
+	r = m.canonical(r)
+	if r.IsEmpty() {
+		return
+	}
+	m.Remove(r)
+	i := sort.Search(len(m.entries), func(i int) bool { return compareCuts(m.entries[i].Range.lower, r.lower) > 0 })
+	m.replace(i, i, MapEntry[K, V]{r, v})
+}
+
+// PutAll puts all the associations from other into this map.
+func (m *TreeRangeMap[K, V]) PutAll(other *TreeRangeMap[K, V]) {
+	for _, e := range other.entries {
+		m.Put(e.Range, e.Value)
+	}
+}
+
+// PutCoalescing maps a range to a specified value, coalescing this range with any existing ranges with the same value
+// that are connected to this range.
+//
+// For example, putting [1..3] and [3..5] with the same value yields a single entry [1..5].
+// If the range is empty, then this is a no-op.
+func (m *TreeRangeMap[K, V]) PutCoalescing(r Range[K], v V) {
+	r = m.canonical(r)
+	if r.IsEmpty() {
+		return
+	}
+
+	// the entry starting before r and the last entry starting within r are the only candidates
+	i := sort.Search(len(m.entries), func(i int) bool { return compareCuts(m.entries[i].Range.lower, r.lower) >= 0 })
+	j := sort.Search(len(m.entries), func(j int) bool { return compareCuts(m.entries[j].Range.lower, r.upper) > 0 })
+	coalesced := r
+	for _, k := range []int{i - 1, j - 1} {
+		if k >= 0 && m.entries[k].Value == v && m.entries[k].Range.IsConnected(r) {
+			coalesced = coalesced.Span(m.entries[k].Range)
+		}
+	}
+	m.Put(coalesced, v)
+}
+
+// Remove removes all associations from this map in the specified range.
+//
+// Ranges overlapping with r are split or truncated as necessary, retaining their values.
+func (m *TreeRangeMap[K, V]) Remove(r Range[K]) {
+	r = m.canonical(r)
+	if r.IsEmpty() {
+		return
+	}
+
+	// all entries in [i, j) have a nonempty intersection with r
+	i := sort.Search(len(m.entries), func(i int) bool { return compareCuts(m.entries[i].Range.upper, r.lower) > 0 })
+	j := sort.Search(len(m.entries), func(j int) bool { return compareCuts(m.entries[j].Range.lower, r.upper) >= 0 })
+	if i >= j {
+		return
+	}
+
+	var rest []MapEntry[K, V]
+	if first := m.entries[i]; compareCuts(first.Range.lower, r.lower) < 0 {
+		rest = append(rest, MapEntry[K, V]{m.canonical(Range[K]{first.Range.lower, r.lower}), first.Value})
+	}
+	if last := m.entries[j-1]; compareCuts(last.Range.upper, r.upper) > 0 {
+		rest = append(rest, MapEntry[K, V]{m.canonical(Range[K]{r.upper, last.Range.upper}), last.Value})
+	}
+	m.replace(i, j, rest...)
+}
+
+// Clear removes all associations from this map.
+func (m *TreeRangeMap[K, V]) Clear() {
+	m.entries = nil
+}
+
+// IsEmpty returns true if this map contains no entries.
+func (m *TreeRangeMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Size returns the number of entries in this map.
+func (m *TreeRangeMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+// Span returns the minimal range enclosing the ranges in this map, or an error if this map is empty.
+func (m *TreeRangeMap[K, V]) Span() (Range[K], error) {
+	if err := precond.CheckStatef(!m.IsEmpty(), "span of an empty range map"); err != nil {
+		return Range[K]{}, err
+	}
+	return Range[K]{m.entries[0].Range.lower, m.entries[len(m.entries)-1].Range.upper}, nil
+}
+
+// Entries returns the entries of this map, in increasing order of the lower bounds of their ranges.
+//
+// The returned slice is a copy, i.e., modifying it does not affect this map.
+func (m *TreeRangeMap[K, V]) Entries() []MapEntry[K, V] {
+	return append([]MapEntry[K, V](nil), m.entries...)
+}
+
+// SubRangeMap returns a new map containing the entries of this map intersected with the specified range.
+//
+// Unlike its Guava counterpart, the returned map is a copy and not a view, i.e., subsequent modifications of this map
+// are not reflected in the returned map.
+func (m *TreeRangeMap[K, V]) SubRangeMap(view Range[K]) *TreeRangeMap[K, V] {
+	sub := &TreeRangeMap[K, V]{domain: m.domain}
+	view = m.canonical(view)
+	for _, e := range m.entries {
+		if e.Range.IsConnected(view) {
+			sub.Put(Range[K]{maxCut(e.Range.lower, view.lower), minCut(e.Range.upper, view.upper)}, e.Value)
+		}
+	}
+	return sub
+}
+
+// String returns a string representation of this map, such as "{[1..3)=a, [5..7]=b}".
+func (m *TreeRangeMap[K, V]) String() string {
+	sb := strings.Builder{}
+	sb.WriteByte('{')
+	for i, e := range m.entries {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(e.Range.String())
+		sb.WriteByte('=')
+		sb.WriteString(fmt.Sprint(e.Value))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// canonical returns the canonical form of r, if this map has a discrete domain.
+func (m *TreeRangeMap[K, V]) canonical(r Range[K]) Range[K] {
+	if m.domain == nil {
+		return r
+	}
+	return r.Canonical(m.domain)
+}
+
+// replace replaces the entries in [i, j) by the given entries.
+func (m *TreeRangeMap[K, V]) replace(i, j int, es ...MapEntry[K, V]) {
+	m.entries = append(m.entries[:i], append(es, m.entries[j:]...)...)
+}
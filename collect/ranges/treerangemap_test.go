@@ -0,0 +1,116 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges_test
+
+import (
+	"testing"
+
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestTreeRangeMap_Put(t *testing.T) {
+	m := ranges.NewTreeRangeMap[int, string]()
+	True(t, m.IsEmpty())
+
+	m.Put(fst(ranges.Closed(1, 10)), "foo")
+	Equal(t, "{[1..10]=foo}", m.String())
+	m.Put(fst(ranges.Open(3, 6)), "bar")
+	Equal(t, "{[1..3]=foo, (3..6)=bar, [6..10]=foo}", m.String())
+	m.Put(fst(ranges.Open(10, 20)), "foo")
+	Equal(t, "{[1..3]=foo, (3..6)=bar, [6..10]=foo, (10..20)=foo}", m.String())
+	m.Put(fst(ranges.ClosedOpen(7, 7)), "baz")
+	Equal(t, 4, m.Size())
+
+	v, ok := m.Get(5)
+	True(t, ok)
+	Equal(t, "bar", v)
+	v, ok = m.Get(10)
+	True(t, ok)
+	Equal(t, "foo", v)
+	_, ok = m.Get(20)
+	False(t, ok)
+
+	e, ok := m.GetEntry(3)
+	True(t, ok)
+	Equal(t, ranges.MapEntry[int, string]{Range: fst(ranges.Closed(1, 3)), Value: "foo"}, e)
+}
+
+func TestTreeRangeMap_Remove(t *testing.T) {
+	m := ranges.NewTreeRangeMap[int, string]()
+	m.Put(fst(ranges.Closed(1, 10)), "foo")
+	m.Put(fst(ranges.Open(10, 20)), "bar")
+
+	m.Remove(fst(ranges.Closed(5, 11)))
+	Equal(t, "{[1..5)=foo, (11..20)=bar}", m.String())
+	m.Remove(fst(ranges.Closed(12, 12)))
+	Equal(t, "{[1..5)=foo, (11..12)=bar, (12..20)=bar}", m.String())
+	m.Remove(ranges.LessThan(12))
+	Equal(t, "{(12..20)=bar}", m.String())
+
+	m.Clear()
+	True(t, m.IsEmpty())
+}
+
+func TestTreeRangeMap_PutCoalescing(t *testing.T) {
+	m := ranges.NewTreeRangeMap[int, string]()
+	m.PutCoalescing(fst(ranges.Closed(1, 3)), "foo")
+	m.PutCoalescing(fst(ranges.Closed(3, 5)), "foo")
+	Equal(t, "{[1..5]=foo}", m.String())
+	m.PutCoalescing(fst(ranges.Open(5, 7)), "bar")
+	m.PutCoalescing(fst(ranges.Closed(9, 9)), "foo")
+	Equal(t, "{[1..5]=foo, (5..7)=bar, [9..9]=foo}", m.String())
+	m.PutCoalescing(fst(ranges.Closed(6, 9)), "foo")
+	Equal(t, "{[1..5]=foo, (5..6)=bar, [6..9]=foo}", m.String())
+	m.PutCoalescing(fst(ranges.Closed(5, 6)), "foo")
+	Equal(t, "{[1..9]=foo}", m.String())
+}
+
+func TestTreeRangeMap_Discrete(t *testing.T) {
+	m := ranges.NewDiscreteTreeRangeMap[int64, string](domain.Int64{})
+	m.PutCoalescing(fst(ranges.Closed[int64](1, 3)), "foo")
+	m.PutCoalescing(fst(ranges.Closed[int64](4, 6)), "foo")
+	m.PutCoalescing(fst(ranges.Closed[int64](7, 9)), "bar")
+	Equal(t, "{[1..7)=foo, [7..10)=bar}", m.String())
+
+	m.Remove(fst(ranges.Open[int64](2, 4)))
+	Equal(t, "{[1..3)=foo, [4..7)=foo, [7..10)=bar}", m.String())
+}
+
+func TestTreeRangeMap_Span(t *testing.T) {
+	m := ranges.NewTreeRangeMap[int, int]()
+	_, err := m.Span()
+	EqualError(t, err, "span of an empty range map")
+
+	m.Put(fst(ranges.Closed(1, 3)), 1)
+	m.Put(ranges.AtLeast(5), 2)
+	Equal(t, "[1..+∞)", fst(m.Span()).String())
+	Len(t, m.Entries(), 2)
+}
+
+func TestTreeRangeMap_SubRangeMap(t *testing.T) {
+	m := ranges.NewTreeRangeMap[int, int]()
+	m.Put(fst(ranges.Closed(1, 3)), 1)
+	m.Put(fst(ranges.Open(5, 7)), 2)
+	m.Put(ranges.AtLeast(9), 3)
+
+	Equal(t, "{[2..3]=1, (5..7)=2, [9..10)=3}", m.SubRangeMap(fst(ranges.ClosedOpen(2, 10))).String())
+	Equal(t, "{}", m.SubRangeMap(fst(ranges.OpenClosed(3, 5))).String())
+
+	other := ranges.NewTreeRangeMap[int, int]()
+	other.PutAll(m)
+	Equal(t, m.Entries(), other.Entries())
+}
@@ -0,0 +1,254 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+
+	"github.com/abc-inc/goava/base/precond"
+	"github.com/abc-inc/goava/collect/domain"
+)
+
+// TreeRangeSet is a set comprising zero or more nonempty, disconnected ranges of type C.
+//
+// Ranges which are connected are coalesced, e.g., adding [1..3) and [3..5) yields [1..5). If the set was created with
+// a discrete domain, ranges are canonicalized before they are added, so that [1..3] and [4..6] are coalesced into
+// [1..7), too.
+//
+// The ranges are kept in a slice sorted by their lower bounds, which allows for logarithmic look-ups.
+// The zero value is an empty set of a continuous domain, ready to use.
+type TreeRangeSet[C cmp.Ordered] struct {
+	ranges []Range[C]
+	domain domain.BoundedDiscreteDomain[C]
+}
+
+// NewTreeRangeSet returns a new set containing the given ranges.
+func NewTreeRangeSet[C cmp.Ordered](rs ...Range[C]) *TreeRangeSet[C] {
+	s := &TreeRangeSet[C]{}
+	for _, r := range rs {
+		s.Add(r)
+	}
+	return s
+}
+
+// NewDiscreteTreeRangeSet returns a new set containing the given ranges, which are canonicalized in the given domain.
+func NewDiscreteTreeRangeSet[C cmp.Ordered](d domain.BoundedDiscreteDomain[C], rs ...Range[C]) *TreeRangeSet[C] {
+	s := &TreeRangeSet[C]{domain: d}
+	for _, r := range rs {
+		s.Add(r)
+	}
+	return s
+}
+
+// Add adds the specified range to this set.
+//
+// Ranges in this set, which are connected to r, are coalesced with r. If r is empty, this is a no-op.
+func (s *TreeRangeSet[C]) Add(r Range[C]) {
+	r = s.canonical(r)
+	if r.IsEmpty() {
+		return
+	}
+
+	// all ranges in [i, j) are connected to r
+	i := sort.Search(len(s.ranges), func(i int) bool { return compareCuts(s.ranges[i].upper, r.lower) >= 0 })
+	j := sort.Search(len(s.ranges), func(j int) bool { return compareCuts(s.ranges[j].lower, r.upper) > 0 })
+	if i < j {
+		r = r.Span(s.ranges[i]).Span(s.ranges[j-1])
+	}
+	s.replace(i, j, r)
+}
+
+// AddAll adds all ranges of the other set to this set.
+func (s *TreeRangeSet[C]) AddAll(other *TreeRangeSet[C]) {
+	for _, r := range other.ranges {
+		s.Add(r)
+	}
+}
+
+// Remove removes the specified range from this set.
+//
+// Ranges in this set, which overlap with r, are split or truncated as necessary. If r is empty, this is a no-op.
+func (s *TreeRangeSet[C]) Remove(r Range[C]) {
+	r = s.canonical(r)
+	if r.IsEmpty() {
+		return
+	}
+
+	// all ranges in [i, j) have a nonempty intersection with r
+	i := sort.Search(len(s.ranges), func(i int) bool { return compareCuts(s.ranges[i].upper, r.lower) > 0 })
+	j := sort.Search(len(s.ranges), func(j int) bool { return compareCuts(s.ranges[j].lower, r.upper) >= 0 })
+	if i >= j {
+		return
+	}
+
+	var rest []Range[C]
+	if first := s.ranges[i]; compareCuts(first.lower, r.lower) < 0 {
+		rest = append(rest, s.canonical(Range[C]{first.lower, r.lower}))
+	}
+	if last := s.ranges[j-1]; compareCuts(last.upper, r.upper) > 0 {
+		rest = append(rest, s.canonical(Range[C]{r.upper, last.upper}))
+	}
+	s.replace(i, j, rest...)
+}
+
+// RemoveAll removes all ranges of the other set from this set.
+func (s *TreeRangeSet[C]) RemoveAll(other *TreeRangeSet[C]) {
+	for _, r := range other.ranges {
+		s.Remove(r)
+	}
+}
+
+// Clear removes all ranges from this set.
+func (s *TreeRangeSet[C]) Clear() {
+	s.ranges = nil
+}
+
+// Contains determines whether any of this set's member ranges contains v.
+func (s *TreeRangeSet[C]) Contains(v C) bool {
+	_, ok := s.RangeContaining(v)
+	return ok
+}
+
+// RangeContaining returns the unique range from this set that contains v, if any.
+func (s *TreeRangeSet[C]) RangeContaining(v C) (Range[C], bool) {
+	i := sort.Search(len(s.ranges), func(i int) bool { return !s.ranges[i].upper.isLessThan(v) })
+	if i < len(s.ranges) && s.ranges[i].Contains(v) {
+		return s.ranges[i], true
+	}
+	return Range[C]{}, false
+}
+
+// Encloses returns true if there exists a member range in this set which encloses the specified range.
+func (s *TreeRangeSet[C]) Encloses(r Range[C]) bool {
+	r = s.canonical(r)
+	i := sort.Search(len(s.ranges), func(i int) bool { return compareCuts(s.ranges[i].upper, r.lower) >= 0 })
+	return i < len(s.ranges) && s.ranges[i].Encloses(r)
+}
+
+// EnclosesAll returns true if for each member range in other there exists a member range in this set which encloses
+// it.
+func (s *TreeRangeSet[C]) EnclosesAll(other *TreeRangeSet[C]) bool {
+	for _, r := range other.ranges {
+		if !s.Encloses(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersects returns true if there exists a nonempty range enclosed by both a member range in this set and the
+// specified range.
+func (s *TreeRangeSet[C]) Intersects(r Range[C]) bool {
+	r = s.canonical(r)
+	if r.IsEmpty() {
+		return false
+	}
+	i := sort.Search(len(s.ranges), func(i int) bool { return compareCuts(s.ranges[i].upper, r.lower) > 0 })
+	return i < len(s.ranges) && compareCuts(s.ranges[i].lower, r.upper) < 0
+}
+
+// IsEmpty returns true if this set contains no ranges.
+func (s *TreeRangeSet[C]) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Size returns the number of disconnected ranges in this set.
+func (s *TreeRangeSet[C]) Size() int {
+	return len(s.ranges)
+}
+
+// Span returns the minimal range which encloses all ranges in this set, or an error if this set is empty.
+func (s *TreeRangeSet[C]) Span() (Range[C], error) {
+	if err := precond.CheckStatef(!s.IsEmpty(), "span of an empty range set"); err != nil {
+		return Range[C]{}, err
+	}
+	return Range[C]{s.ranges[0].lower, s.ranges[len(s.ranges)-1].upper}, nil
+}
+
+// AsRanges returns the disconnected ranges that make up this set, in increasing order of their lower bounds.
+//
+// The returned slice is a copy, i.e., modifying it does not affect this set.
+func (s *TreeRangeSet[C]) AsRanges() []Range[C] {
+	return append([]Range[C](nil), s.ranges...)
+}
+
+// Complement returns a new set containing all values not contained in this set.
+func (s *TreeRangeSet[C]) Complement() *TreeRangeSet[C] {
+	c := &TreeRangeSet[C]{domain: s.domain}
+	prev := cut[C]{kind: belowAll}
+	for _, r := range s.ranges {
+		c.Add(Range[C]{prev, r.lower})
+		prev = r.upper
+	}
+	c.Add(Range[C]{prev, cut[C]{kind: aboveAll}})
+	return c
+}
+
+// SubRangeSet returns a new set containing the intersection of this set with the specified range.
+//
+// Unlike its Guava counterpart, the returned set is a copy and not a view, i.e., subsequent modifications of this set
+// are not reflected in the returned set.
+func (s *TreeRangeSet[C]) SubRangeSet(view Range[C]) *TreeRangeSet[C] {
+	sub := &TreeRangeSet[C]{domain: s.domain}
+	view = s.canonical(view)
+	for _, r := range s.ranges {
+		if r.IsConnected(view) {
+			sub.Add(Range[C]{maxCut(r.lower, view.lower), minCut(r.upper, view.upper)})
+		}
+	}
+	return sub
+}
+
+// Equal returns true if both sets contain the same ranges.
+func (s *TreeRangeSet[C]) Equal(other *TreeRangeSet[C]) bool {
+	if len(s.ranges) != len(other.ranges) {
+		return false
+	}
+	for i, r := range s.ranges {
+		if r != other.ranges[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a string representation of this set, such as "[[1..3), [5..7]]".
+func (s *TreeRangeSet[C]) String() string {
+	sb := strings.Builder{}
+	sb.WriteByte('[')
+	for i, r := range s.ranges {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(r.String())
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// canonical returns the canonical form of r, if this set has a discrete domain.
+func (s *TreeRangeSet[C]) canonical(r Range[C]) Range[C] {
+	if s.domain == nil {
+		return r
+	}
+	return r.Canonical(s.domain)
+}
+
+// replace replaces the ranges in [i, j) by the given ranges.
+func (s *TreeRangeSet[C]) replace(i, j int, rs ...Range[C]) {
+	s.ranges = append(s.ranges[:i], append(rs, s.ranges[j:]...)...)
+}
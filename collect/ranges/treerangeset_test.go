@@ -0,0 +1,153 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranges_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/abc-inc/goava/collect/domain"
+	"github.com/abc-inc/goava/collect/ranges"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestTreeRangeSet_Add(t *testing.T) {
+	s := ranges.NewTreeRangeSet[int]()
+	True(t, s.IsEmpty())
+
+	s.Add(fst(ranges.Closed(1, 10)))
+	Equal(t, "[[1..10]]", s.String())
+	s.Add(fst(ranges.ClosedOpen(11, 15)))
+	Equal(t, "[[1..10], [11..15)]", s.String())
+	s.Add(fst(ranges.ClosedOpen(15, 20)))
+	Equal(t, "[[1..10], [11..20)]", s.String())
+	s.Add(fst(ranges.OpenClosed(0, 0)))
+	Equal(t, "[[1..10], [11..20)]", s.String())
+	s.Add(fst(ranges.Closed(3, 12)))
+	Equal(t, "[[1..20)]", s.String())
+	s.Add(ranges.AtLeast(30))
+	Equal(t, 2, s.Size())
+	Equal(t, "[[1..20), [30..+∞)]", s.String())
+}
+
+func TestTreeRangeSet_Remove(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 10)), fst(ranges.ClosedOpen(11, 20)))
+
+	s.Remove(fst(ranges.Open(5, 10)))
+	Equal(t, "[[1..5], [10..10], [11..20)]", s.String())
+	s.Remove(fst(ranges.ClosedOpen(7, 7)))
+	Equal(t, "[[1..5], [10..10], [11..20)]", s.String())
+	s.Remove(fst(ranges.Closed(5, 12)))
+	Equal(t, "[[1..5), (12..20)]", s.String())
+	s.Remove(ranges.All[int]())
+	True(t, s.IsEmpty())
+}
+
+func TestTreeRangeSet_AddAll_RemoveAll(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)))
+	s.AddAll(ranges.NewTreeRangeSet(fst(ranges.Closed(2, 5)), fst(ranges.Closed(7, 9))))
+	Equal(t, "[[1..5], [7..9]]", s.String())
+	s.RemoveAll(ranges.NewTreeRangeSet(fst(ranges.Closed(4, 8))))
+	Equal(t, "[[1..4), (8..9]]", s.String())
+
+	s.Clear()
+	True(t, s.IsEmpty())
+}
+
+func TestTreeRangeSet_Discrete(t *testing.T) {
+	s := ranges.NewDiscreteTreeRangeSet[int](domain.Int{}, fst(ranges.Closed(1, 3)), fst(ranges.Closed(4, 6)))
+	Equal(t, "[[1..7)]", s.String())
+
+	s.Remove(fst(ranges.Open(2, 4)))
+	Equal(t, "[[1..3), [4..7)]", s.String())
+	True(t, s.Encloses(fst(ranges.Closed(1, 2))))
+	True(t, s.Encloses(fst(ranges.Open(0, 3))))
+	False(t, s.Intersects(fst(ranges.Open(2, 4))))
+
+	s.Add(ranges.AtLeast(math.MaxInt))
+	Equal(t, "[[1..3), [4..7), [9223372036854775807..+∞)]", s.String())
+	Equal(t, "[[-9223372036854775808..1), [3..4), [7..9223372036854775807)]", s.Complement().String())
+}
+
+func TestTreeRangeSet_Contains(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7)), ranges.AtLeast(10))
+	for _, v := range []int{1, 2, 3, 6, 10, math.MaxInt} {
+		True(t, s.Contains(v), v)
+	}
+	for _, v := range []int{math.MinInt, 0, 4, 5, 7, 9} {
+		False(t, s.Contains(v), v)
+	}
+
+	r, ok := s.RangeContaining(6)
+	True(t, ok)
+	Equal(t, fst(ranges.Open(5, 7)), r)
+	_, ok = s.RangeContaining(5)
+	False(t, ok)
+}
+
+func TestTreeRangeSet_Encloses(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7)))
+	True(t, s.Encloses(fst(ranges.Closed(1, 3))))
+	True(t, s.Encloses(fst(ranges.Open(1, 2))))
+	True(t, s.Encloses(fst(ranges.Closed(6, 6))))
+	False(t, s.Encloses(fst(ranges.Closed(5, 6))))
+	False(t, s.Encloses(fst(ranges.Closed(2, 6))))
+
+	True(t, s.EnclosesAll(ranges.NewTreeRangeSet(fst(ranges.Closed(2, 3)), fst(ranges.Closed(6, 6)))))
+	False(t, s.EnclosesAll(ranges.NewTreeRangeSet(fst(ranges.Closed(2, 3)), fst(ranges.Closed(7, 7)))))
+}
+
+func TestTreeRangeSet_Intersects(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7)))
+	True(t, s.Intersects(fst(ranges.Closed(3, 4))))
+	True(t, s.Intersects(ranges.All[int]()))
+	False(t, s.Intersects(fst(ranges.OpenClosed(3, 5))))
+	False(t, s.Intersects(fst(ranges.ClosedOpen(2, 2))))
+	False(t, s.Intersects(ranges.AtLeast(7)))
+}
+
+func TestTreeRangeSet_Span(t *testing.T) {
+	_, err := ranges.NewTreeRangeSet[int]().Span()
+	EqualError(t, err, "span of an empty range set")
+
+	s := ranges.NewTreeRangeSet(fst(ranges.Open(5, 7)), fst(ranges.Closed(1, 3)))
+	Equal(t, "[1..7)", fst(s.Span()).String())
+	Equal(t, []ranges.Range[int]{fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7))}, s.AsRanges())
+}
+
+func TestTreeRangeSet_Complement(t *testing.T) {
+	Equal(t, "[(-∞..+∞)]", ranges.NewTreeRangeSet[int]().Complement().String())
+	Equal(t, "[]", ranges.NewTreeRangeSet(ranges.All[int]()).Complement().String())
+
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7)))
+	c := s.Complement()
+	Equal(t, "[(-∞..1), (3..5], [7..+∞)]", c.String())
+	True(t, s.Equal(c.Complement()))
+	False(t, s.Equal(c))
+}
+
+func TestTreeRangeSet_SubRangeSet(t *testing.T) {
+	s := ranges.NewTreeRangeSet(fst(ranges.Closed(1, 3)), fst(ranges.Open(5, 7)), ranges.AtLeast(9))
+	Equal(t, "[[2..3], (5..7), [9..10)]", s.SubRangeSet(fst(ranges.ClosedOpen(2, 10))).String())
+	Equal(t, "[]", s.SubRangeSet(fst(ranges.OpenClosed(3, 5))).String())
+	True(t, s.Equal(s.SubRangeSet(ranges.All[int]())))
+}
+
+func TestTreeRangeSet_ZeroValue(t *testing.T) {
+	var s ranges.TreeRangeSet[string]
+	s.Add(fst(ranges.Closed("a", "c")))
+	True(t, s.Contains("b"))
+	False(t, s.Contains("d"))
+}
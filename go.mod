@@ -14,10 +14,11 @@
 
 module github.com/abc-inc/goava
 
-go 1.19
+go 1.21
 
 require (
 	github.com/jonboulle/clockwork v0.3.0
+	github.com/rivo/uniseg v0.4.7
 	github.com/stretchr/testify v1.8.1
 	golang.org/x/text v0.4.0
 	golang.org/x/tools v0.3.0
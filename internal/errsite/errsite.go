@@ -0,0 +1,73 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errsite records and prints the call sites of errors returned by the check functions of packages precond
+// and verify.
+package errsite
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// modulePrefix is the prefix of the import paths of all packages in this module.
+var modulePrefix = strings.TrimSuffix(reflect.TypeOf(Site{}).PkgPath(), "internal/errsite")
+
+// Site is the call stack of a failed check, which is resolved to the location of the offending call lazily.
+type Site struct {
+	pcs []uintptr
+}
+
+// Capture records the call stack of its caller.
+func Capture() Site {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	return Site{append([]uintptr(nil), pcs[:n]...)}
+}
+
+// String returns the location ("file:line") of the first caller outside of this module, i.e., the offending call
+// site in the user's code, even if the check was made by another package of this module on behalf of the user.
+// Test files of this module are considered user code.
+func (s Site) String() string {
+	if len(s.pcs) == 0 {
+		return "unknown"
+	}
+	frames := runtime.CallersFrames(s.pcs)
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, modulePrefix) || strings.HasSuffix(f.File, "_test.go") || !more {
+			return f.File + ":" + strconv.Itoa(f.Line)
+		}
+	}
+}
+
+// Format formats an error with the message msg, which was returned at site, according to the fmt.Formatter
+// interface. The verb %+v additionally prints the location of site and the cause, if any.
+func Format(s fmt.State, verb rune, msg string, site Site, cause error) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		_, _ = fmt.Fprintf(s, "%s\n\tat %s", msg, site)
+		if cause != nil {
+			_, _ = fmt.Fprintf(s, "\ncaused by: %+v", cause)
+		}
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", msg)
+	default:
+		_, _ = io.WriteString(s, msg)
+	}
+}
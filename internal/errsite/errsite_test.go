@@ -0,0 +1,52 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errsite_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/abc-inc/goava/internal/errsite"
+	. "github.com/stretchr/testify/require"
+)
+
+type testErr struct {
+	msg   string
+	site  errsite.Site
+	cause error
+}
+
+func (e testErr) Error() string {
+	return e.msg
+}
+
+func (e testErr) Format(s fmt.State, verb rune) {
+	errsite.Format(s, verb, e.msg, e.site, e.cause)
+}
+
+func TestSite(t *testing.T) {
+	Regexp(t, `/internal/errsite/errsite_test\.go:\d+$`, errsite.Capture().String())
+	Equal(t, "unknown", errsite.Site{}.String())
+}
+
+func TestFormat(t *testing.T) {
+	err := testErr{"failed", errsite.Capture(), errors.New("cause")}
+	Equal(t, "failed", fmt.Sprint(err))
+	Equal(t, "failed", fmt.Sprintf("%v", err))
+	Equal(t, `"failed"`, fmt.Sprintf("%q", err))
+	Regexp(t, `^failed\n\tat .*/errsite_test\.go:\d+\ncaused by: cause$`, fmt.Sprintf("%+v", err))
+	Equal(t, "failed\n\tat unknown", fmt.Sprintf("%+v", testErr{msg: "failed"}))
+}
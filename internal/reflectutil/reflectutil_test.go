@@ -0,0 +1,46 @@
+// Copyright 2020 The Goava authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reflectutil_test
+
+import (
+	"io/fs"
+	"testing"
+	"unsafe"
+
+	"github.com/abc-inc/goava/internal/reflectutil"
+	. "github.com/stretchr/testify/require"
+)
+
+func TestIsNil(t *testing.T) {
+	var err error
+	var p *int
+	var typedErr error = (*fs.PathError)(nil)
+
+	True(t, reflectutil.IsNil(nil))
+	True(t, reflectutil.IsNil(err))
+	True(t, reflectutil.IsNil(p))
+	True(t, reflectutil.IsNil(typedErr))
+	True(t, reflectutil.IsNil([]int(nil)))
+	True(t, reflectutil.IsNil(map[int]int(nil)))
+	True(t, reflectutil.IsNil((chan int)(nil)))
+	True(t, reflectutil.IsNil((func())(nil)))
+	True(t, reflectutil.IsNil(unsafe.Pointer(nil)))
+
+	False(t, reflectutil.IsNil(0))
+	False(t, reflectutil.IsNil(""))
+	False(t, reflectutil.IsNil(&p))
+	False(t, reflectutil.IsNil([]int{}))
+	False(t, reflectutil.IsNil(struct{}{}))
+}